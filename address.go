@@ -0,0 +1,45 @@
+package onfido
+
+import (
+	"context"
+)
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// SearchAddresses looks up the addresses of a UK postcode with the Onfido address picker.
+//
+// The returned addresses can be used as is in the address of an applicant.
+func (c *Client) SearchAddresses(ctx context.Context, postcode string) ([]Address, error) {
+	if postcode == "" {
+		return nil, ErrInvalidPostcode
+	}
+
+	var addresses []Address
+
+	req := func() error {
+		params := map[string]string{"postcode": postcode}
+
+		resp, err := c.client.Get(ctx, "/addresses/pick", c.getHttpRequestOptions(ctx, params, nil)...)
+		if err != nil {
+			return err
+		}
+
+		var list struct {
+			Addresses []Address `json:"addresses"`
+		}
+		if err := c.getResponseOrError(resp, &list); err != nil {
+			return err
+		}
+
+		addresses = list.Addresses
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
@@ -0,0 +1,48 @@
+package onfido_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddress(t *testing.T) {
+	run := setupTestRun(t)
+	defer run.teardown()
+
+	t.Run("SearchAddresses", testSearchAddresses(run))
+}
+
+func testSearchAddresses(run *testRun) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "SearchWithoutErrors",
+			input: "SW4 6EH",
+		},
+		{
+			name:    "ReturnErrorOnEmptyPostcode",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				addresses, err := run.client.SearchAddresses(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotEmpty(t, addresses, "expected addresses to be found")
+				for _, address := range addresses {
+					assert.Equal(t, tt.input, address.Postcode, "expected address to match the postcode")
+				}
+			})
+		}
+	}
+}
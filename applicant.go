@@ -2,6 +2,8 @@ package onfido
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -22,8 +24,20 @@ type Applicant struct {
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 	Href        string     `json:"href,omitempty"`
 	Sandbox     bool       `json:"sandbox,omitempty"`
+	Consents    []Consent  `json:"consents,omitempty"`
 	Address     *Address   `json:"address,omitempty"`
 	Location    *Location  `json:"location,omitempty"`
+	Raw         RawJSON    `json:"-"`
+}
+
+func (a *Applicant) UnmarshalJSON(data []byte) error {
+	type applicant Applicant
+	if err := json.Unmarshal(data, (*applicant)(a)); err != nil {
+		return err
+	}
+
+	a.Raw = newRawJSON(data)
+	return nil
 }
 
 type CreateApplicantPayload struct {
@@ -41,34 +55,132 @@ type CreateApplicantPayload struct {
 }
 
 type IdNumber struct {
-	Type      string `json:"type,omitempty"`
-	Value     string `json:"value,omitempty"`
-	StateCode string `json:"state_code,omitempty"`
+	Type      IdNumberType `json:"type,omitempty"`
+	Value     string       `json:"value,omitempty"`
+	StateCode string       `json:"state_code,omitempty"`
+}
+
+// IdNumberType represents the kind of an identification number of an applicant
+//   - The types declared here are not exhaustive, the API may support more types
+type IdNumberType string
+
+const (
+	// IdNumberTypeSSN is a US social security number, either in full (123-45-6789) or its last 4 digits
+	IdNumberTypeSSN             IdNumberType = "ssn"
+	IdNumberTypeSocialInsurance IdNumberType = "social_insurance"
+	IdNumberTypeTaxID           IdNumberType = "tax_id"
+	IdNumberTypeIdentityCard    IdNumberType = "identity_card"
+	IdNumberTypeDrivingLicence  IdNumberType = "driving_licence"
+)
+
+// validate checks that the id number has a type and a value, and that the value of a SSN is well formed
+func (n IdNumber) validate(field string) error {
+	if n.Type == "" {
+		return &ValidationError{Field: field + ".type", Message: field + ".type is required"}
+	}
+	if n.Value == "" {
+		return &ValidationError{Field: field + ".value", Message: field + ".value is required"}
+	}
+
+	if n.Type == IdNumberTypeSSN && !isSSN(n.Value) {
+		return &ValidationError{
+			Field:   field + ".value",
+			Message: fmt.Sprintf("%s.value %q is not a SSN, expected 9 digits such as 123-45-6789 or the last 4 digits", field, n.Value),
+		}
+	}
+	return nil
+}
+
+// isSSN reports whether value is a full SSN, with or without dashes, or the last 4 digits of one
+func isSSN(value string) bool {
+	switch len(value) {
+	case 4, 9:
+		return isDigits(value)
+	case 11:
+		return value[3] == '-' && value[6] == '-' && isDigits(value[:3]+value[4:6]+value[7:])
+	default:
+		return false
+	}
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
 }
 
 type Consent struct {
-	Granted bool   `json:"granted,omitempty"`
-	Name    string `json:"name,omitempty"`
+	// Granted is always sent so that a consent can be revoked
+	Granted   bool        `json:"granted"`
+	Name      ConsentName `json:"name,omitempty"`
+	GrantedAt *time.Time  `json:"granted_at,omitempty"`
+}
+
+// ConsentName represents the name of a consent given by an applicant
+type ConsentName string
+
+const (
+	ConsentPrivacyNoticesRead      ConsentName = "privacy_notices_read"
+	ConsentSSNVerification         ConsentName = "ssn_verification"
+	ConsentPhoneNumberVerification ConsentName = "phone_number_verification"
+)
+
+// RequiredConsents returns the consents every applicant must grant before a check can be run
+func RequiredConsents() []ConsentName {
+	return []ConsentName{ConsentPrivacyNoticesRead}
+}
+
+// HasConsent reports whether the applicant granted the named consent
+func (a *Applicant) HasConsent(name ConsentName) bool {
+	for _, consent := range a.Consents {
+		if consent.Name == name {
+			return consent.Granted
+		}
+	}
+	return false
 }
 
 type Location struct {
-	IpAddress          string `json:"ip_address,omitempty"`
-	CountryOfResidence string `json:"country_of_residence,omitempty"`
+	IpAddress          string      `json:"ip_address,omitempty"`
+	CountryOfResidence CountryCode `json:"country_of_residence,omitempty"`
 }
 
 type Address struct {
-	Country        string `json:"country,omitempty"`
-	Postcode       string `json:"postcode,omitempty"`
-	FlatNumber     string `json:"flat_number,omitempty"`
-	BuildingNumber string `json:"building_number,omitempty"`
-	BuildingName   string `json:"building_name,omitempty"`
-	Street         string `json:"street,omitempty"`
-	SubStreet      string `json:"sub_street,omitempty"`
-	Town           string `json:"town,omitempty"`
-	State          string `json:"state,omitempty"`
-	Line1          string `json:"line1,omitempty"`
-	Line2          string `json:"line2,omitempty"`
-	Line3          string `json:"line3,omitempty"`
+	Country        CountryCode `json:"country,omitempty"`
+	Postcode       string      `json:"postcode,omitempty"`
+	FlatNumber     string      `json:"flat_number,omitempty"`
+	BuildingNumber string      `json:"building_number,omitempty"`
+	BuildingName   string      `json:"building_name,omitempty"`
+	Street         string      `json:"street,omitempty"`
+	SubStreet      string      `json:"sub_street,omitempty"`
+	Town           string      `json:"town,omitempty"`
+	State          string      `json:"state,omitempty"`
+	Line1          string      `json:"line1,omitempty"`
+	Line2          string      `json:"line2,omitempty"`
+	Line3          string      `json:"line3,omitempty"`
+}
+
+// validate checks the id numbers and the country codes of the payload
+func (p CreateApplicantPayload) validate() error {
+	for i, idNumber := range p.IdNumbers {
+		if err := idNumber.validate(fmt.Sprintf("id_numbers[%d]", i)); err != nil {
+			return err
+		}
+	}
+	if p.Address != nil {
+		if err := p.Address.Country.validate("address.country"); err != nil {
+			return err
+		}
+	}
+	if p.Location != nil {
+		if err := p.Location.CountryOfResidence.validate("location.country_of_residence"); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // ------------------------------------------------------------------
@@ -87,7 +199,8 @@ type listApplicantsOptions struct {
 	*paginationOption      `json:",inline"`
 	*limitPaginationOption `json:",inline"`
 	// IncludeDeleted is a flag to include deleted applicants in the response
-	IncludeDeleted bool `json:"include_deleted,omitempty"`
+	IncludeDeleted bool          `json:"include_deleted,omitempty"`
+	Sort           sortDirection `json:"sort,omitempty"`
 }
 
 func WithIncludeDeletedApplicants() ListApplicantsOption {
@@ -96,12 +209,23 @@ func WithIncludeDeletedApplicants() ListApplicantsOption {
 	}
 }
 
+// WithApplicantSort sorts the list of applicants by creation date
+func WithApplicantSort(sort sortDirection) ListApplicantsOption {
+	return func(o *listApplicantsOptions) {
+		o.Sort = sort
+	}
+}
+
 // ------------------------------------------------------------------
 //                              METHODS
 // ------------------------------------------------------------------
 
 // CreateApplicant creates a new applicant in the Onfido API
 func (c *Client) CreateApplicant(ctx context.Context, payload CreateApplicantPayload) (*Applicant, error) {
+	if err := payload.validate(); err != nil {
+		return nil, err
+	}
+
 	var applicant Applicant
 
 	req := func() error {
@@ -110,7 +234,7 @@ func (c *Client) CreateApplicant(ctx context.Context, payload CreateApplicantPay
 			return err
 		}
 
-		resp, err := c.client.Post(ctx, "/applicants", body)
+		resp, err := c.client.Post(ctx, "/applicants", body, callHttpOptions(ctx)...)
 		if err != nil {
 			return err
 		}
@@ -130,7 +254,15 @@ func (c *Client) UpdateApplicant(ctx context.Context, applicantId string, payloa
 	if applicantId == "" {
 		return nil, ErrInvalidId
 	}
+	if err := payload.validate(); err != nil {
+		return nil, err
+	}
 
+	return c.updateApplicant(ctx, applicantId, payload)
+}
+
+// updateApplicant sends payload as the update of an applicant, only its encoded fields are updated
+func (c *Client) updateApplicant(ctx context.Context, applicantId string, payload any) (*Applicant, error) {
 	var applicant Applicant
 
 	req := func() error {
@@ -139,7 +271,7 @@ func (c *Client) UpdateApplicant(ctx context.Context, applicantId string, payloa
 			return err
 		}
 
-		resp, err := c.client.Put(ctx, "/applicants/"+applicantId, body, c.getHttpRequestOptions(nil, nil)...)
+		resp, err := c.client.Put(ctx, "/applicants/"+applicantId, body, c.getHttpRequestOptions(ctx, nil, nil)...)
 		if err != nil {
 			return err
 		}
@@ -163,7 +295,7 @@ func (c *Client) RetrieveApplicant(ctx context.Context, applicantId string) (*Ap
 	var applicant Applicant
 
 	req := func() error {
-		resp, err := c.client.Get(ctx, "/applicants/"+applicantId, c.getHttpRequestOptions(nil, nil)...)
+		resp, err := c.client.Get(ctx, "/applicants/"+applicantId, c.getHttpRequestOptions(ctx, nil, nil)...)
 		if err != nil {
 			return err
 		}
@@ -186,7 +318,7 @@ func (c *Client) ListApplicants(ctx context.Context, opts ...IsListApplicantOpti
 	req := func() error {
 		params := c.getListApplicantParams(opts...)
 
-		resp, err := c.client.Get(ctx, "/applicants", c.getHttpRequestOptions(params, nil)...)
+		resp, err := c.client.Get(ctx, "/applicants", c.getHttpRequestOptions(ctx, params, nil)...)
 		if err != nil {
 			return err
 		}
@@ -200,7 +332,7 @@ func (c *Client) ListApplicants(ctx context.Context, opts ...IsListApplicantOpti
 
 		applicants = list.Applicants
 		pageDetails = c.extractPageDetails(resp.Headers)
-		return nil
+		return c.checkPageRange(params, len(applicants), pageDetails)
 	}
 
 	if err := c.do(ctx, req); err != nil {
@@ -217,7 +349,7 @@ func (c *Client) DeleteApplicant(ctx context.Context, applicantId string) error
 	}
 
 	req := func() error {
-		resp, err := c.client.Delete(ctx, "/applicants/"+applicantId, c.getHttpRequestOptions(nil, nil)...)
+		resp, err := c.client.Delete(ctx, "/applicants/"+applicantId, c.getHttpRequestOptions(ctx, nil, nil)...)
 		if err != nil {
 			return err
 		}
@@ -239,7 +371,7 @@ func (c *Client) RestoreApplicant(ctx context.Context, applicantId string) error
 	}
 
 	req := func() error {
-		resp, err := c.client.Post(ctx, "/applicants/"+applicantId+"/restore", nil, c.getHttpRequestOptions(nil, nil)...)
+		resp, err := c.client.Post(ctx, "/applicants/"+applicantId+"/restore", nil, c.getHttpRequestOptions(ctx, nil, nil)...)
 		if err != nil {
 			return err
 		}
@@ -254,6 +386,34 @@ func (c *Client) RestoreApplicant(ctx context.Context, applicantId string) error
 	return nil
 }
 
+// ScanApplicants returns a Scanner over all the applicants matching the options, fetching the pages as needed
+func (c *Client) ScanApplicants(opts ...IsListApplicantOption) *Scanner[Applicant] {
+	return NewScanner(func(ctx context.Context, page int) ([]Applicant, *PageDetails, error) {
+		return c.ListApplicants(ctx, append(opts[:len(opts):len(opts)], WithPage(page))...)
+	})
+}
+
+// GrantApplicantConsents grants consents to an existing applicant in the Onfido API, leaving its other
+// attributes untouched. The [RequiredConsents] are granted if no consent is named
+func (c *Client) GrantApplicantConsents(ctx context.Context, applicantId string, names ...ConsentName) (*Applicant, error) {
+	if applicantId == "" {
+		return nil, ErrInvalidId
+	}
+	if len(names) == 0 {
+		names = RequiredConsents()
+	}
+
+	consents := make([]Consent, len(names))
+	for i, name := range names {
+		consents[i] = Consent{Name: name, Granted: true}
+	}
+
+	// only the consents are sent, the zero date of birth of a CreateApplicantPayload would be sent too
+	return c.updateApplicant(ctx, applicantId, struct {
+		Consents []Consent `json:"consents"`
+	}{consents})
+}
+
 func (c Client) getListApplicantParams(opts ...IsListApplicantOption) (params map[string]string) {
 	pg, lm := paginationOption{}, limitPaginationOption{}
 
@@ -279,5 +439,9 @@ func (c Client) getListApplicantParams(opts ...IsListApplicantOption) (params ma
 		params["include_deleted"] = "true"
 	}
 
+	if options.Sort != "" {
+		params["sort"] = string(options.Sort)
+	}
+
 	return
 }
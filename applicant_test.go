@@ -1,6 +1,10 @@
 package onfido_test
 
 import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
 	"strings"
 	"testing"
 
@@ -17,11 +21,56 @@ func TestApplicant(t *testing.T) {
 	t.Run("CreateApplicant", testCreateApplicant(run, testApplicant))
 	t.Run("RetrieveApplicant", testRetrieveApplicant(run, testApplicant.ID))
 	t.Run("UpdateApplicant", testUpdateApplicant(run, testApplicant.ID))
+	t.Run("GrantApplicantConsents", testGrantApplicantConsents(run, testApplicant.ID))
 	t.Run("DeleteApplicant", testDeleteApplicant(run, testApplicant.ID))
 	t.Run("RestoreApplicant", testRestoreApplicant(run, testApplicant.ID))
 	t.Run("ListApplicants", testListApplicants(run))
 }
 
+func TestApplicantConsents(t *testing.T) {
+	applicant := &onfido.Applicant{
+		Consents: []onfido.Consent{
+			{Name: onfido.ConsentPrivacyNoticesRead, Granted: true},
+			{Name: onfido.ConsentSSNVerification, Granted: false},
+		},
+	}
+
+	assert.True(t, applicant.HasConsent(onfido.ConsentPrivacyNoticesRead), "expected granted consent to be found")
+	assert.False(t, applicant.HasConsent(onfido.ConsentSSNVerification), "expected revoked consent not to be granted")
+	assert.False(t, applicant.HasConsent(onfido.ConsentPhoneNumberVerification), "expected missing consent not to be granted")
+}
+
+func testGrantApplicantConsents(run *testRun, applicantId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "GrantWithoutErrors",
+			input: applicantId,
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				applicant, err := run.client.GrantApplicantConsents(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotNil(t, applicant, "expected applicant to be updated")
+			})
+		}
+	}
+}
+
 func testCreateApplicant(run *testRun, setTestApplicant *onfido.Applicant) func(*testing.T) {
 	tests := []testCase[onfido.CreateApplicantPayload]{
 		{
@@ -300,6 +349,15 @@ func testListApplicants(run *testRun) func(*testing.T) {
 		{
 			name: "ListWithPaginationAndLimit",
 		},
+		{
+			name: "ListPastLastPage",
+		},
+		{
+			name: "ListWithSortAsc",
+		},
+		{
+			name: "ListWithSortDesc",
+		},
 		{
 			name: "ListWithIncludeDeleted",
 		},
@@ -356,6 +414,26 @@ func testListApplicants(run *testRun) func(*testing.T) {
 						assert.NoErrorf(t, err, expectedNoError, tt.name, err)
 						assertPaginationApplicantLastPage(t, applicants, page)
 					}
+				case tt.name == "ListPastLastPage":
+					applicants, _, err := run.client.ListApplicants(run.ctx, onfido.WithPage(4), onfido.WithPageLimit(2))
+					assert.Truef(t, errors.Is(err, onfido.ErrPageOutOfRange), "expected page out of range error. got %v", err)
+					assert.Nil(t, applicants, "expected no applicants to be returned")
+				case strings.Contains(tt.name, "Sort"):
+					sort := onfido.SortDesc
+					if strings.HasSuffix(tt.name, "Asc") {
+						sort = onfido.SortAsc
+					}
+
+					applicants, _, err := run.client.ListApplicants(run.ctx, onfido.WithApplicantSort(sort))
+					assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+					for i := 1; i < len(applicants); i++ {
+						previous, current := applicants[i-1].CreatedAt, applicants[i].CreatedAt
+						if sort == onfido.SortAsc {
+							assert.False(t, current.Before(*previous), "expected applicants to be sorted in ascending order")
+						} else {
+							assert.False(t, current.After(*previous), "expected applicants to be sorted in descending order")
+						}
+					}
 				case isIncludeDeleted:
 					// Cleanup applicants
 					if err := cleanupApplicants(run.ctx, run.client); err != nil {
@@ -479,3 +557,143 @@ func assertPaginationApplicantLastPage[T any](t *testing.T, data []T, page *onfi
 	assert.NotNilf(t, page.FirstPage, "expected first page to be set. got %v", page.FirstPage)
 	assert.NotNilf(t, page.PrevPage, "expected prev page to be set. got %v", page.PrevPage)
 }
+
+func TestApplicantValidation(t *testing.T) {
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		t.Errorf("expected no request to be sent, got %s %s", req.Method, req.URL.Path)
+		return nil, http.ErrNotSupported
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	tests := []struct {
+		name      string
+		payload   onfido.CreateApplicantPayload
+		wantField string
+	}{
+		{
+			name:      "InvalidAddressCountry",
+			payload:   onfido.CreateApplicantPayload{FirstName: "John", LastName: "Doe", Address: &onfido.Address{Country: "UK", Postcode: "SW1A 1AA"}},
+			wantField: "address.country",
+		},
+		{
+			name:      "InvalidCountryOfResidence",
+			payload:   onfido.CreateApplicantPayload{FirstName: "John", LastName: "Doe", Location: &onfido.Location{CountryOfResidence: "gbr"}},
+			wantField: "location.country_of_residence",
+		},
+		{
+			name:      "MissingIdNumberType",
+			payload:   onfido.CreateApplicantPayload{FirstName: "John", LastName: "Doe", IdNumbers: []onfido.IdNumber{{Value: "123-45-6789"}}},
+			wantField: "id_numbers[0].type",
+		},
+		{
+			name:      "MissingIdNumberValue",
+			payload:   onfido.CreateApplicantPayload{FirstName: "John", LastName: "Doe", IdNumbers: []onfido.IdNumber{{Type: onfido.IdNumberTypeTaxID}}},
+			wantField: "id_numbers[0].value",
+		},
+		{
+			name: "MalformedSSN",
+			payload: onfido.CreateApplicantPayload{FirstName: "John", LastName: "Doe", IdNumbers: []onfido.IdNumber{
+				{Type: onfido.IdNumberTypeDrivingLicence, Value: "D1234567", StateCode: "CA"},
+				{Type: onfido.IdNumberTypeSSN, Value: "123-456-789"},
+			}},
+			wantField: "id_numbers[1].value",
+		},
+		{
+			name:      "ShortSSN",
+			payload:   onfido.CreateApplicantPayload{FirstName: "John", LastName: "Doe", IdNumbers: []onfido.IdNumber{{Type: onfido.IdNumberTypeSSN, Value: "789"}}},
+			wantField: "id_numbers[0].value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, create := range map[string]func() error{
+				"CreateApplicant": func() error {
+					_, err := client.CreateApplicant(context.Background(), tt.payload)
+					return err
+				},
+				"UpdateApplicant": func() error {
+					_, err := client.UpdateApplicant(context.Background(), "applicant-1", tt.payload)
+					return err
+				},
+			} {
+				err := create()
+
+				var validationErr *onfido.ValidationError
+				if assert.ErrorAsf(t, err, &validationErr, expectedError, name, err) {
+					assert.Equal(t, tt.wantField, validationErr.Field)
+				}
+			}
+		})
+	}
+
+	t.Run("AcceptWellFormedSSN", func(t *testing.T) {
+		var sent int
+		transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			sent++
+			return &http.Response{StatusCode: http.StatusCreated, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(`{"id":"applicant-1"}`)), Request: req}, nil
+		})
+
+		client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		for _, ssn := range []string{"123-45-6789", "123456789", "6789"} {
+			_, err := client.CreateApplicant(context.Background(), onfido.CreateApplicantPayload{
+				FirstName: "John",
+				LastName:  "Doe",
+				IdNumbers: []onfido.IdNumber{{Type: onfido.IdNumberTypeSSN, Value: ssn}},
+			})
+			assert.NoErrorf(t, err, expectedNoError, "CreateApplicant with SSN "+ssn, err)
+		}
+		assert.Equal(t, 3, sent, "expected every applicant to be sent")
+	})
+
+	t.Run("CountryCodeIsValid", func(t *testing.T) {
+		assert.True(t, onfido.CountryCode("GBR").IsValid(), "expected GBR to be valid")
+		assert.True(t, onfido.CountryCode("XKX").IsValid(), "expected XKX to be valid")
+		assert.False(t, onfido.CountryCode("GB").IsValid(), "expected alpha-2 codes to be invalid")
+		assert.False(t, onfido.CountryCode("XYZ").IsValid(), "expected unassigned codes to be invalid")
+	})
+}
+
+func TestGrantApplicantConsents(t *testing.T) {
+	var method, body string
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		b, _ := io.ReadAll(req.Body)
+		method, body = req.Method, string(b)
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(`{"id":"applicant-1"}`)), Request: req}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	t.Run("SendOnlyConsents", func(t *testing.T) {
+		_, err := client.GrantApplicantConsents(context.Background(), "applicant-1", onfido.ConsentSSNVerification)
+		assert.NoErrorf(t, err, expectedNoError, "GrantApplicantConsents", err)
+		assert.Equal(t, http.MethodPut, method)
+		assert.JSONEq(t, `{"consents":[{"granted":true,"name":"ssn_verification"}]}`, body)
+	})
+
+	t.Run("GrantRequiredConsentsByDefault", func(t *testing.T) {
+		_, err := client.GrantApplicantConsents(context.Background(), "applicant-1")
+		assert.NoErrorf(t, err, expectedNoError, "GrantApplicantConsents", err)
+		assert.JSONEq(t, `{"consents":[{"granted":true,"name":"privacy_notices_read"}]}`, body)
+	})
+
+	t.Run("ReturnRequiredConsentsCopy", func(t *testing.T) {
+		consents := onfido.RequiredConsents()
+		consents[0] = onfido.ConsentSSNVerification
+		assert.Equal(t, []onfido.ConsentName{onfido.ConsentPrivacyNoticesRead}, onfido.RequiredConsents())
+	})
+}
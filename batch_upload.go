@@ -0,0 +1,179 @@
+package onfido
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ------------------------------------------------------------------
+//                           BATCH UPLOAD
+// ------------------------------------------------------------------
+
+// defaultBatchConcurrency is the number of uploads run in parallel when none is configured
+const defaultBatchConcurrency = 4
+
+// BatchUploadItem is a media uploaded by a [BatchUploader], i.e. an [UploadDocumentPayload] or an [UploadLivePhotoPayload]
+type BatchUploadItem interface {
+	isBatchUploadItem()
+}
+
+func (UploadDocumentPayload) isBatchUploadItem()  {}
+func (UploadLivePhotoPayload) isBatchUploadItem() {}
+
+// BatchUploadResult is the outcome of the upload of a single item
+type BatchUploadResult struct {
+	// Index is the position of the item in the batch
+	Index int
+	Item  BatchUploadItem
+	// Document is the uploaded document, set when the item is an UploadDocumentPayload
+	Document *Document
+	// LivePhoto is the uploaded live photo, set when the item is an UploadLivePhotoPayload
+	LivePhoto *LivePhoto
+	Err       error
+}
+
+// BatchUploadProgress is reported every time an item of the batch completes
+type BatchUploadProgress struct {
+	Completed int
+	Total     int
+	Result    BatchUploadResult
+}
+
+// BatchUploadError is returned when some items of a batch failed to upload
+type BatchUploadError struct {
+	Failed []BatchUploadResult
+}
+
+func (e BatchUploadError) Error() string {
+	msgs := make([]string, len(e.Failed))
+	for i, result := range e.Failed {
+		msgs[i] = fmt.Sprintf("item %d: %v", result.Index, result.Err)
+	}
+	return fmt.Sprintf("%d batch uploads failed: %s", len(e.Failed), strings.Join(msgs, "; "))
+}
+
+func (e BatchUploadError) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, result := range e.Failed {
+		errs[i] = result.Err
+	}
+	return errs
+}
+
+// BatchUploader uploads the media of an applicant concurrently, with bounded parallelism.
+//
+// Uploads go through the client they were created from and share its retry and rate limit handling.
+type BatchUploader struct {
+	client      *Client
+	concurrency int
+	onProgress  func(BatchUploadProgress)
+}
+
+// ------------------------------------------------------------------
+//                              OPTIONS
+// ------------------------------------------------------------------
+
+type BatchUploaderOption func(*BatchUploader)
+
+// WithBatchConcurrency sets the maximum number of uploads run in parallel
+func WithBatchConcurrency(concurrency int) BatchUploaderOption {
+	return func(b *BatchUploader) {
+		if concurrency > 0 {
+			b.concurrency = concurrency
+		}
+	}
+}
+
+// WithBatchProgress registers a callback called every time an item completes.
+// Calls are serialized, the callback doesn't need to be safe for concurrent use.
+func WithBatchProgress(fn func(BatchUploadProgress)) BatchUploaderOption {
+	return func(b *BatchUploader) {
+		b.onProgress = fn
+	}
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// NewBatchUploader creates a BatchUploader using the client
+func (c *Client) NewBatchUploader(opts ...BatchUploaderOption) *BatchUploader {
+	b := &BatchUploader{client: c, concurrency: defaultBatchConcurrency}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Upload uploads the items for an applicant and returns the result of every item, in the order of the items.
+//
+// The applicant ID is set on every item that doesn't have one. When some items fail, the results of all
+// the items are returned along with a [BatchUploadError] describing the failures.
+func (b *BatchUploader) Upload(ctx context.Context, applicantID string, items ...BatchUploadItem) ([]BatchUploadResult, error) {
+	results := make([]BatchUploadResult, len(items))
+
+	var mu sync.Mutex
+	completed := 0
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(b.concurrency)
+
+	for i, item := range items {
+		group.Go(func() error {
+			result := b.upload(ctx, applicantID, i, item)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			results[i] = result
+			completed++
+			if b.onProgress != nil {
+				b.onProgress(BatchUploadProgress{Completed: completed, Total: len(items), Result: result})
+			}
+
+			// failures are collected in the results rather than cancelling the whole batch
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return results, err
+	}
+
+	var failed []BatchUploadResult
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	if len(failed) > 0 {
+		return results, &BatchUploadError{Failed: failed}
+	}
+
+	return results, nil
+}
+
+func (b *BatchUploader) upload(ctx context.Context, applicantID string, index int, item BatchUploadItem) BatchUploadResult {
+	result := BatchUploadResult{Index: index, Item: item}
+
+	switch payload := item.(type) {
+	case UploadDocumentPayload:
+		if payload.ApplicantID == "" {
+			payload.ApplicantID = applicantID
+		}
+		result.Document, result.Err = b.client.UploadDocument(ctx, payload)
+	case UploadLivePhotoPayload:
+		if payload.ApplicantID == "" {
+			payload.ApplicantID = applicantID
+		}
+		result.LivePhoto, result.Err = b.client.UploadLivePhoto(ctx, payload)
+	default:
+		result.Err = fmt.Errorf("unsupported batch upload item %T", item)
+	}
+
+	return result
+}
@@ -0,0 +1,91 @@
+package onfido
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/besafe-labs/onfido-go-sdk/internal/httpclient"
+)
+
+// ------------------------------------------------------------------
+//                            CALL OPTIONS
+// ------------------------------------------------------------------
+
+// CallOption customizes the requests made with a context, see [ContextWithCallOptions]
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	headers http.Header
+	params  map[string]string
+	timeout time.Duration
+}
+
+type callOptionsKey struct{}
+
+// ContextWithCallOptions returns a copy of ctx carrying call options, which apply to every
+// request made with the returned context without reconfiguring the client:
+//
+//	ctx = onfido.ContextWithCallOptions(ctx, onfido.WithCallTimeout(5*time.Second))
+//	applicant, err := client.RetrieveApplicant(ctx, applicantID)
+//
+// Options are added to the ones already carried by ctx.
+func ContextWithCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	options := callOptions{headers: make(http.Header), params: make(map[string]string)}
+	if parent, ok := ctx.Value(callOptionsKey{}).(callOptions); ok {
+		for k, v := range parent.headers {
+			options.headers[k] = v
+		}
+		for k, v := range parent.params {
+			options.params[k] = v
+		}
+		options.timeout = parent.timeout
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return context.WithValue(ctx, callOptionsKey{}, options)
+}
+
+// WithCallHeader sets a header on the requests, it overrides the headers set by the client
+func WithCallHeader(key, value string) CallOption {
+	return func(o *callOptions) {
+		o.headers.Set(key, value)
+	}
+}
+
+// WithCallQueryParam sets a query parameter on the requests, it overrides the parameters set by the method
+func WithCallQueryParam(key, value string) CallOption {
+	return func(o *callOptions) {
+		o.params[key] = value
+	}
+}
+
+// WithCallTimeout bounds each call, retries and response body included
+func WithCallTimeout(timeout time.Duration) CallOption {
+	return func(o *callOptions) {
+		o.timeout = timeout
+	}
+}
+
+// callHttpOptions returns the call options carried by ctx as request options
+func callHttpOptions(ctx context.Context) []httpclient.RequestOption {
+	options, ok := ctx.Value(callOptionsKey{}).(callOptions)
+	if !ok {
+		return nil
+	}
+
+	var opts []httpclient.RequestOption
+	if len(options.headers) > 0 {
+		opts = append(opts, httpclient.WithRequestHttpHeaders(options.headers))
+	}
+	if len(options.params) > 0 {
+		opts = append(opts, httpclient.WithHttpQueryParams(options.params))
+	}
+	if options.timeout > 0 {
+		opts = append(opts, httpclient.WithHttpRequestTimeout(options.timeout))
+	}
+	return opts
+}
@@ -0,0 +1,114 @@
+package onfido
+
+import "slices"
+
+// ------------------------------------------------------------------
+//                           CAPABILITIES
+// ------------------------------------------------------------------
+
+// Resource groups of the Onfido API
+const (
+	ResourceApplicants        = "applicants"
+	ResourceDocuments         = "documents"
+	ResourceWorkflowRuns      = "workflow_runs"
+	ResourceChecks            = "checks"
+	ResourceReports           = "reports"
+	ResourceWebhooks          = "webhooks"
+	ResourceSdkTokens         = "sdk_tokens"
+	ResourceLivePhotos        = "live_photos"
+	ResourceLiveVideos        = "live_videos"
+	ResourceMotionCaptures    = "motion_captures"
+	ResourceIDPhotos          = "id_photos"
+	ResourceWatchlistMonitors = "watchlist_monitors"
+	ResourceAddresses         = "addresses"
+	ResourceExtractions       = "extractions"
+)
+
+// supportedEndpoints lists, per resource group, the client methods implemented by this build
+var supportedEndpoints = map[string][]string{
+	ResourceApplicants: {
+		"CreateApplicant", "UpdateApplicant", "RetrieveApplicant", "ListApplicants", "DeleteApplicant", "RestoreApplicant",
+		"GrantApplicantConsents", "DownloadApplicantMedia",
+	},
+	ResourceDocuments: {
+		"UploadDocument", "UploadDocumentFromBytes", "RetrieveDocument", "ListDocuments",
+		"DownloadDocument", "DownloadDocumentStream", "DownloadDocumentTo", "DownloadDocumentNFCFace",
+		"DownloadDocumentVideo", "DownloadDocumentVideoStream", "DownloadDocumentVideoTo",
+	},
+	ResourceWorkflowRuns: {
+		"CreateWorkflowRun", "RetrieveWorkflowRun", "ListWorkflowRuns",
+		"ListAllWorkflowRuns", "RetrieveWorkflowRunEvidenceSummaryFile",
+		"ListWorkflowRunTasks", "RetrieveWorkflowRunTask", "CompleteWorkflowRunTask",
+		"GenerateWorkflowRunTimelineFile", "RetrieveWorkflowRunTimelineFile", "DownloadWorkflowRunEvidence",
+	},
+	ResourceChecks: {
+		"RetrieveCheck", "ListChecks", "ResumeCheck",
+	},
+	ResourceReports: {
+		"RetrieveReport", "ResumeReport",
+	},
+	ResourceWebhooks: {
+		"CreateWebhook", "UpdateWebhook", "RetrieveWebhook", "ListWebhooks", "DeleteWebhook",
+	},
+	ResourceSdkTokens: {
+		"GenerateSdkToken",
+	},
+	ResourceLivePhotos: {
+		"UploadLivePhoto", "RetrieveLivePhoto", "ListLivePhotos", "DownloadLivePhoto", "DownloadLivePhotoStream", "DownloadLivePhotoTo",
+	},
+	ResourceLiveVideos: {
+		"RetrieveLiveVideo", "ListLiveVideos", "DownloadLiveVideo", "DownloadLiveVideoStream", "DownloadLiveVideoTo", "DownloadLiveVideoFrame",
+	},
+	ResourceMotionCaptures: {
+		"RetrieveMotionCapture", "ListMotionCaptures", "DownloadMotionCapture", "DownloadMotionCaptureStream", "DownloadMotionCaptureTo", "DownloadMotionCaptureFrame",
+	},
+	ResourceIDPhotos: {
+		"UploadIDPhoto", "RetrieveIDPhoto", "ListIDPhotos", "DownloadIDPhoto", "DownloadIDPhotoStream", "DownloadIDPhotoTo",
+	},
+	ResourceWatchlistMonitors: {
+		"CreateWatchlistMonitor", "RetrieveWatchlistMonitor", "ListWatchlistMonitors", "DeleteWatchlistMonitor",
+		"ListWatchlistMonitorMatches", "SetWatchlistMonitorMatchesStatus", "ForceWatchlistMonitorReportCreation",
+	},
+	ResourceAddresses: {
+		"SearchAddresses",
+	},
+	ResourceExtractions: {
+		"ExtractDocument",
+	},
+}
+
+// SDKCapabilities describes what this build of the SDK supports
+type SDKCapabilities struct {
+	// SDKVersion is the version of the SDK
+	SDKVersion string
+	// APIVersion is the Onfido API version the SDK is pinned to
+	APIVersion string
+	// Resources maps every supported resource group to the names of the client methods implementing its endpoints
+	Resources map[string][]string
+}
+
+// Capabilities reports the SDK version, the pinned API version and the resource groups and endpoints
+// supported by this build, so that callers can feature-detect at runtime
+func Capabilities() SDKCapabilities {
+	resources := make(map[string][]string, len(supportedEndpoints))
+	for resource, endpoints := range supportedEndpoints {
+		resources[resource] = slices.Clone(endpoints)
+	}
+
+	return SDKCapabilities{
+		SDKVersion: CURRENT_CLIENT_VERSION,
+		APIVersion: LATEST_API_VERSION,
+		Resources:  resources,
+	}
+}
+
+// Supports reports whether the resource group is supported
+func (c SDKCapabilities) Supports(resource string) bool {
+	_, ok := c.Resources[resource]
+	return ok
+}
+
+// SupportsEndpoint reports whether the client method implementing an endpoint of the resource group is supported
+func (c SDKCapabilities) SupportsEndpoint(resource, endpoint string) bool {
+	return slices.Contains(c.Resources[resource], endpoint)
+}
@@ -0,0 +1,35 @@
+package onfido_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapabilities(t *testing.T) {
+	capabilities := onfido.Capabilities()
+
+	t.Run("ReportVersions", func(t *testing.T) {
+		assert.Equal(t, onfido.CURRENT_CLIENT_VERSION, capabilities.SDKVersion)
+		assert.Equal(t, onfido.LATEST_API_VERSION, capabilities.APIVersion)
+	})
+
+	t.Run("ReportSupportedResources", func(t *testing.T) {
+		assert.True(t, capabilities.Supports(onfido.ResourceApplicants), "expected applicants to be supported")
+		assert.True(t, capabilities.SupportsEndpoint(onfido.ResourceWorkflowRuns, "CreateWorkflowRun"), "expected CreateWorkflowRun to be supported")
+		assert.False(t, capabilities.Supports("unknown"), "expected unknown resource not to be supported")
+		assert.False(t, capabilities.SupportsEndpoint(onfido.ResourceApplicants, "Unknown"), "expected unknown endpoint not to be supported")
+	})
+
+	t.Run("ReportOnlyExistingMethods", func(t *testing.T) {
+		clientType := reflect.TypeOf(&onfido.Client{})
+		for resource, endpoints := range capabilities.Resources {
+			for _, endpoint := range endpoints {
+				_, ok := clientType.MethodByName(endpoint)
+				assert.Truef(t, ok, "expected %s endpoint %s to be a client method", resource, endpoint)
+			}
+		}
+	})
+}
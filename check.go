@@ -0,0 +1,185 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ------------------------------------------------------------------
+//                              CHECK
+// ------------------------------------------------------------------
+
+// Check represents a check in the Onfido API
+type Check struct {
+	ID                             string      `json:"id,omitempty"`
+	ApplicantID                    string      `json:"applicant_id,omitempty"`
+	Status                         CheckStatus `json:"status,omitempty"`
+	Result                         CheckResult `json:"result,omitempty"`
+	ReportIDs                      []string    `json:"report_ids,omitempty"`
+	Tags                           []string    `json:"tags,omitempty"`
+	WebhookIDs                     []string    `json:"webhook_ids,omitempty"`
+	ApplicantProvidesData          bool        `json:"applicant_provides_data,omitempty"`
+	PrivacyNoticesReadConsentGiven bool        `json:"privacy_notices_read_consent_given,omitempty"`
+	Paused                         bool        `json:"paused,omitempty"`
+	Sandbox                        bool        `json:"sandbox,omitempty"`
+	Version                        string      `json:"version,omitempty"`
+	Href                           string      `json:"href,omitempty"`
+	FormURI                        string      `json:"form_uri,omitempty"`
+	RedirectURI                    string      `json:"redirect_uri,omitempty"`
+	ResultsURI                     string      `json:"results_uri,omitempty"`
+	CreatedAt                      *time.Time  `json:"created_at,omitempty"`
+	Raw                            RawJSON     `json:"-"`
+}
+
+func (c *Check) UnmarshalJSON(data []byte) error {
+	type check Check
+	if err := json.Unmarshal(data, (*check)(c)); err != nil {
+		return err
+	}
+
+	c.Raw = newRawJSON(data)
+	return nil
+}
+
+// CheckStatus represents the status of a check
+type CheckStatus string
+
+const (
+	CheckStatusInProgress        CheckStatus = "in_progress"
+	CheckStatusAwaitingApplicant CheckStatus = "awaiting_applicant"
+	CheckStatusComplete          CheckStatus = "complete"
+	CheckStatusWithdrawn         CheckStatus = "withdrawn"
+	CheckStatusPaused            CheckStatus = "paused"
+	CheckStatusReopened          CheckStatus = "reopened"
+)
+
+// IsComplete reports whether the check is complete and its result is available
+func (s CheckStatus) IsComplete() bool {
+	return s == CheckStatusComplete
+}
+
+// IsTerminal reports whether the check reached a status it won't leave on its own, i.e. complete or withdrawn
+func (s CheckStatus) IsTerminal() bool {
+	return s == CheckStatusComplete || s == CheckStatusWithdrawn
+}
+
+// CheckResult represents the overall result of a check
+type CheckResult string
+
+const (
+	CheckResultClear    CheckResult = "clear"
+	CheckResultConsider CheckResult = "consider"
+)
+
+// ------------------------------------------------------------------
+//                              OPTIONS
+// ------------------------------------------------------------------
+
+type IsListCheckOption interface {
+	isListCheckOption()
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// RetrieveCheck retrieves a check from the Onfido API
+func (c *Client) RetrieveCheck(ctx context.Context, checkId string) (*Check, error) {
+	if checkId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var check Check
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/checks/"+checkId, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &check)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &check, nil
+}
+
+// ListChecks retrieves the checks of an applicant from the Onfido API
+func (c *Client) ListChecks(ctx context.Context, applicantId string, opts ...IsListCheckOption) ([]Check, *PageDetails, error) {
+	if applicantId == "" {
+		return nil, nil, ErrInvalidId
+	}
+
+	var checks []Check
+	var pageDetails PageDetails
+
+	req := func() error {
+		params := c.getListCheckParams(applicantId, opts...)
+
+		resp, err := c.client.Get(ctx, "/checks", c.getHttpRequestOptions(ctx, params, nil)...)
+		if err != nil {
+			return err
+		}
+
+		var list struct {
+			Checks []Check `json:"checks"`
+		}
+		if err := c.getResponseOrError(resp, &list); err != nil {
+			return err
+		}
+
+		checks = list.Checks
+		pageDetails = c.extractPageDetails(resp.Headers)
+		return c.checkPageRange(params, len(checks), pageDetails)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, nil, err
+	}
+
+	return checks, &pageDetails, nil
+}
+
+// ResumeCheck resumes a paused check in the Onfido API
+func (c *Client) ResumeCheck(ctx context.Context, checkId string) error {
+	if checkId == "" {
+		return ErrInvalidId
+	}
+
+	req := func() error {
+		resp, err := c.client.Post(ctx, "/checks/"+checkId+"/resume", nil, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, nil)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c Client) getListCheckParams(applicantId string, opts ...IsListCheckOption) (params map[string]string) {
+	pg, lm := paginationOption{}, limitPaginationOption{}
+
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case PaginationOption:
+			opt(&pg)
+		case LimitPaginationOption:
+			opt(&lm)
+		}
+	}
+
+	params = c.getPaginationOptions(pg, lm)
+	params["applicant_id"] = applicantId
+
+	return
+}
@@ -0,0 +1,148 @@
+package onfido_test
+
+import (
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	run := setupTestRun(t)
+	defer run.teardown()
+
+	applicant, err := run.client.CreateApplicant(run.ctx, onfido.CreateApplicantPayload{
+		FirstName: "John",
+		LastName:  "CheckTest",
+	})
+	if err != nil {
+		t.Fatalf("error creating applicant: %v", err)
+	}
+
+	t.Run("RetrieveCheck", testRetrieveCheck(run))
+	t.Run("ListChecks", testListChecks(run, applicant.ID))
+	t.Run("ResumeCheck", testResumeCheck(run))
+}
+
+func TestCheckStatus(t *testing.T) {
+	tests := []struct {
+		status     onfido.CheckStatus
+		complete   bool
+		isTerminal bool
+	}{
+		{status: onfido.CheckStatusInProgress},
+		{status: onfido.CheckStatusAwaitingApplicant},
+		{status: onfido.CheckStatusPaused},
+		{status: onfido.CheckStatusReopened},
+		{status: onfido.CheckStatusComplete, complete: true, isTerminal: true},
+		{status: onfido.CheckStatusWithdrawn, isTerminal: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			assert.Equalf(t, tt.complete, tt.status.IsComplete(), "expected IsComplete to be %v", tt.complete)
+			assert.Equalf(t, tt.isTerminal, tt.status.IsTerminal(), "expected IsTerminal to be %v", tt.isTerminal)
+		})
+	}
+}
+
+func testRetrieveCheck(run *testRun) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "resource_not_found",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				check, err := run.client.RetrieveCheck(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotNil(t, check, "expected check to be fetched")
+				assert.Equal(t, tt.input, check.ID, "expected check ID to match")
+			})
+		}
+	}
+}
+
+func testListChecks(run *testRun, applicantId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "ListWithoutErrors",
+			input: applicantId,
+		},
+		{
+			name:    "ReturnErrorOnEmptyApplicantID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				checks, page, err := run.client.ListChecks(run.ctx, tt.input, onfido.WithPage(1), onfido.WithPageLimit(10))
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotNil(t, page, "expected page details to be fetched")
+				for _, check := range checks {
+					assert.Equal(t, tt.input, check.ApplicantID, "expected check to belong to correct applicant")
+				}
+			})
+		}
+	}
+}
+
+func testResumeCheck(run *testRun) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "resource_not_found",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := run.client.ResumeCheck(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+			})
+		}
+	}
+}
@@ -3,19 +3,23 @@ package onfido
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/textproto"
+	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/besafe-labs/onfido-go-sdk/internal/httpclient"
+	"github.com/besafe-labs/onfido-go-sdk/internal/utils"
 )
 
 const (
@@ -33,38 +37,136 @@ const (
 
 // Client is a client for the Onfido API
 type Client struct {
-	client *httpclient.HttpClient
-
-	Endpoint  string
-	Retries   int
-	RetryWait time.Duration
+	client      *httpclient.HttpClient
+	regionErr   error
+	stats       *statsRecorder
+	retryPolicy RetryPolicy
+	chunkSize   int64
+	docCheck    bool
+
+	Endpoint   string
+	APIVersion string
+	Retries    int
+	RetryWait  time.Duration
 }
 
-// NewClient creates a new Client
+// NewClient creates a new Client.
+//
+// apiToken can be left empty when the token is provided with [WithTokenProvider].
 func NewClient(apiToken string, opts ...ClientOption) (*Client, error) {
-	if apiToken == "" {
-		return nil, fmt.Errorf("apiToken is required")
-	}
-
 	options := &clientOptions{}
 	for _, opt := range opts {
 		opt(options)
 	}
 
-	baseURL := fmt.Sprintf("https://api.%s.onfido.com", DEFAULT_API_REGION)
+	if apiToken == "" && options.tokenProvider == nil {
+		return nil, fmt.Errorf("apiToken is required")
+	}
+
+	region := DEFAULT_API_REGION
 	if options.region != "" {
-		baseURL = fmt.Sprintf("https://api.%s.onfido.com", options.region)
+		region = options.region
+	}
+	baseURL := fmt.Sprintf("https://api.%s.onfido.com", region)
+	if options.baseURL != "" {
+		u, err := url.Parse(options.baseURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid base URL %q: must be an absolute URL", options.baseURL)
+		}
+		baseURL = strings.TrimSuffix(options.baseURL, "/")
 	}
 
 	headers := make(http.Header)
+	for k, v := range options.headers {
+		headers[http.CanonicalHeaderKey(k)] = v
+	}
 	headers.Set("Content-Type", "application/json")
-	headers.Set("User-Agent", "Go-Onfido/"+CURRENT_CLIENT_VERSION)
-	headers.Set("Authorization", "Token token="+apiToken)
+	userAgent := "Go-Onfido/" + CURRENT_CLIENT_VERSION
+	if options.appName != "" {
+		userAgent += " " + options.appName
+		if options.appVersion != "" {
+			userAgent += "/" + options.appVersion
+		}
+	}
+	headers.Set("User-Agent", userAgent)
+	if options.tokenProvider == nil {
+		headers.Set("Authorization", "Token token="+apiToken)
+	}
 
-	endpoint := fmt.Sprintf("%s/%s", baseURL, LATEST_API_VERSION)
-	client := httpclient.NewHttpClient(endpoint, httpclient.WithHttpHeaders(headers))
+	apiVersion := LATEST_API_VERSION
+	if options.apiVersion != "" {
+		if !apiVersionPattern.MatchString(options.apiVersion) {
+			return nil, fmt.Errorf("invalid API version %q: must look like %s", options.apiVersion, LATEST_API_VERSION)
+		}
+		apiVersion = options.apiVersion
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", baseURL, apiVersion)
+
+	httpOpts := []httpclient.ClientOption{
+		httpclient.WithHttpHeaders(headers),
+		httpclient.WithHttpMaxResponseSize(options.maxResponseSize),
+		httpclient.WithHttpLogger(options.logger),
+		httpclient.WithHttpLogArgs(requestMetadataLogArgs),
+	}
+
+	transport, err := options.httpTransport()
+	if err != nil {
+		return nil, err
+	}
+	if options.debug != nil {
+		transport = newDebugTransport(options.debug, transport)
+	}
+	httpOpts = append(httpOpts, httpclient.WithHttpTransport(transport))
+	if options.timeout != nil {
+		httpOpts = append(httpOpts, httpclient.WithHttpTimeout(*options.timeout))
+	}
+	if options.tokenProvider != nil {
+		httpOpts = append(httpOpts, httpclient.WithHttpAuthorization(tokenAuthorization(options.tokenProvider, region, options.regionGuard)))
+	}
+	if options.maxRetryDuration > 0 {
+		httpOpts = append(httpOpts, httpclient.WithHttpMaxRetryDuration(options.maxRetryDuration))
+	}
+	if options.maxRetryWait != nil {
+		httpOpts = append(httpOpts, httpclient.WithHttpMaxRetryWait(*options.maxRetryWait))
+	}
+	if options.logLevels != nil {
+		httpOpts = append(httpOpts, httpclient.WithHttpLogLevels(options.logLevels.request, options.logLevels.retry))
+	}
+	if options.onBackoff != nil {
+		httpOpts = append(httpOpts, httpclient.WithHttpBackoffHook(httpclient.BackoffFunc(options.onBackoff)))
+	}
 
-	return &Client{client, endpoint, options.retries, options.retryWait}, nil
+	var stats *statsRecorder
+	if options.statsWindow > 0 {
+		stats = newStatsRecorder(options.statsWindow)
+		httpOpts = append(httpOpts, httpclient.WithHttpRequestHook(stats.record))
+	}
+	if options.metrics != nil {
+		httpOpts = append(httpOpts, httpclient.WithHttpRequestHook(metricsHook(options.metrics)))
+	}
+	for _, hook := range options.requestHooks {
+		httpOpts = append(httpOpts, httpclient.WithHttpRequestHook(hook.httpHook()))
+	}
+	client := httpclient.NewHttpClient(endpoint, httpOpts...)
+
+	var regionErr error
+	if options.regionGuard && options.tokenProvider == nil {
+		regionErr = checkTokenRegion(apiToken, region)
+	}
+
+	return &Client{
+		client:      client,
+		regionErr:   regionErr,
+		stats:       stats,
+		retryPolicy: options.retryPolicy,
+		chunkSize:   options.chunkSize,
+		docCheck:    options.documentCheck,
+		Endpoint:    endpoint,
+		APIVersion:  apiVersion,
+		Retries:     options.retries,
+		RetryWait:   options.retryWait,
+	}, nil
 }
 
 // Close closes the idle connections of the underlying HTTP client.
@@ -75,6 +177,10 @@ func (c *Client) Close() {
 }
 
 func (c *Client) do(ctx context.Context, req func() error) error {
+	if c.regionErr != nil {
+		return c.regionErr
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -90,7 +196,7 @@ func (c *Client) do(ctx context.Context, req func() error) error {
 
 func (c Client) buildJSON(payload interface{}) (httpclient.JsonBody, error) {
 	if payload == nil {
-		return nil, errors.New("payload is required")
+		return nil, ErrInvalidPayload
 	}
 
 	pb, err := json.Marshal(payload)
@@ -110,11 +216,23 @@ type isMultipartPayload interface {
 	toMultipartMap() (map[string]interface{}, error)
 }
 
+// multipartFile is a file held in memory, written to a multipart body as a file part
+type multipartFile struct {
+	name string
+	data []byte
+}
+
 func (c Client) buildMultipart(payload isMultipartPayload) (body *httpclient.MultipartBody, err error) {
 	var formValues map[string]interface{}
 	switch v := payload.(type) {
 	case UploadDocumentPayload:
 		formValues, err = v.toMultipartMap()
+	case UploadLivePhotoPayload:
+		formValues, err = v.toMultipartMap()
+	case UploadIDPhotoPayload:
+		formValues, err = v.toMultipartMap()
+	case uploadDocumentBytesPayload:
+		formValues, err = v.toMultipartMap()
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert payload to multipart map: %w", err)
@@ -132,25 +250,15 @@ func (c Client) buildMultipart(payload isMultipartPayload) (body *httpclient.Mul
 			// Read the file content
 			fb, err := io.ReadAll(v)
 			if err != nil {
-				log.Fatalln(err)
+				return nil, fmt.Errorf("failed to read file %s: %w", key, err)
 			}
 
-			// Create a new MIME header because ONFIDO API doesn't accept application/octet-stream,
-			// it returns content_type spoofed error
-			h := make(textproto.MIMEHeader)
-			h.Set("Content-Disposition",
-				fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
-					escapeQuotes("file"), escapeQuotes(v.Name())))
-			h.Set("Content-Type", http.DetectContentType(fb))
-
-			// Create a new part in the multipart writer
-			fileWriter, err := body.CreatePart(h)
-			if err != nil {
-				log.Fatalln(err)
+			if err := writeFilePart(body, key, v.Name(), fb); err != nil {
+				return nil, err
 			}
-
-			if _, err := io.Copy(fileWriter, bytes.NewReader(fb)); err != nil {
-				return nil, fmt.Errorf("failed to copy file %s: %w", key, err)
+		case multipartFile:
+			if err := writeFilePart(body, key, v.name, v.data); err != nil {
+				return nil, err
 			}
 		case map[string]interface{}, []map[string]interface{}:
 			pb, err := json.Marshal(v)
@@ -175,15 +283,114 @@ func (c Client) buildMultipart(payload isMultipartPayload) (body *httpclient.Mul
 	return
 }
 
-func (c Client) getHttpRequestOptions(params map[string]string, headers http.Header) []httpclient.RequestOption {
-	opts := []httpclient.RequestOption{httpclient.WithHttpRetries(c.Retries, c.RetryWait)}
+// detectContentType sniffs the content type of a file, recognizing the HEIC images
+// http.DetectContentType doesn't know about
+func detectContentType(data []byte) string {
+	if len(data) >= 12 && string(data[4:8]) == "ftyp" {
+		switch string(data[8:12]) {
+		case "heic", "heix":
+			return "image/heic"
+		}
+	}
+	return http.DetectContentType(data)
+}
+
+// writeFilePart writes a file to the multipart body under the "file" field
+func writeFilePart(body *httpclient.MultipartBody, key, name string, data []byte) error {
+	// Create a new MIME header because ONFIDO API doesn't accept application/octet-stream,
+	// it returns content_type spoofed error
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition",
+		fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			escapeQuotes("file"), escapeQuotes(name)))
+	h.Set("Content-Type", detectContentType(data))
+
+	// Create a new part in the multipart writer
+	fileWriter, err := body.CreatePart(h)
+	if err != nil {
+		return fmt.Errorf("failed to create part for file %s: %w", key, err)
+	}
+
+	if _, err := io.Copy(fileWriter, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("failed to copy file %s: %w", key, err)
+	}
+	return nil
+}
+
+// download streams the file at path into memory, downloads are not subject to the maximum response size
+func (c *Client) download(ctx context.Context, path string) (*Download, error) {
+	body, err := c.downloadStream(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return readDownload(body)
+}
+
+// readDownload reads a download stream into memory then closes it
+func readDownload(body *DownloadStream) (*Download, error) {
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read download: %w", err)
+	}
+
+	return &Download{
+		Data:        data,
+		ContentType: body.ContentType,
+		Filename:    body.Filename,
+		Size:        int64(len(data)),
+	}, nil
+}
+
+// downloadStream returns the unbuffered body of a successful download, the caller must close it
+func (c *Client) downloadStream(ctx context.Context, path string) (*DownloadStream, error) {
+	resp, err := c.openDownload(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DownloadStream{
+		ReadCloser:    resp.Body,
+		ContentType:   resp.Headers.Get("Content-Type"),
+		Filename:      filenameFromContentDisposition(resp.Headers.Get("Content-Disposition")),
+		ContentLength: resp.ContentLength,
+	}, nil
+}
+
+// openDownload sends a download request, the body of the returned response must be closed
+func (c *Client) openDownload(ctx context.Context, path string, headers http.Header) (*httpclient.HttpStreamResponse, error) {
+	resp, err := c.client.GetStream(ctx, path, c.getHttpRequestOptions(ctx, nil, headers)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		buffered, err := c.client.Buffer(resp)
+		if err != nil {
+			return nil, err
+		}
+		// redirects aren't followed, a 302 is a failed download like any other status
+		if err := c.getError(buffered, false); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("unexpected status %d for download", resp.StatusCode)
+	}
+
+	return resp, nil
+}
+
+func (c Client) getHttpRequestOptions(ctx context.Context, params map[string]string, headers http.Header) []httpclient.RequestOption {
+	opts := []httpclient.RequestOption{c.getRetryOption()}
 	if params != nil {
 		opts = append(opts, httpclient.WithHttpQueryParams(params))
 	}
 	if headers != nil {
 		opts = append(opts, httpclient.WithRequestHttpHeaders(headers))
 	}
-	return opts
+	return append(opts, callHttpOptions(ctx)...)
 }
 
 func (c Client) getResponseOrError(resp *httpclient.HttpResponse, dest interface{}) error {
@@ -212,8 +419,14 @@ func (c Client) getError(resp *httpclient.HttpResponse, ingoreFound bool) error
 			Error *OnfidoError `json:"error"`
 		}
 		if err := resp.DecodeJSON(&onfidoError); err != nil {
-			return &OnfidoError{Type: "unknown internal error", Message: fmt.Sprintf("OnfidoErrorDecode: %v", err.Error())}
+			onfidoError.Error = &OnfidoError{Type: "unknown internal error", Message: fmt.Sprintf("OnfidoErrorDecode: %v", err.Error())}
+		}
+		if onfidoError.Error == nil {
+			onfidoError.Error = &OnfidoError{Type: "unknown internal error", Message: resp.Status}
 		}
+		onfidoError.Error.RequestID = resp.Headers.Get(RequestIDHeader)
+		onfidoError.Error.StatusCode = resp.StatusCode
+		onfidoError.Error.RawBody = resp.Body
 		return onfidoError.Error
 	}
 
@@ -227,9 +440,32 @@ func (c Client) getError(resp *httpclient.HttpResponse, ingoreFound bool) error
 type ClientOption func(*clientOptions)
 
 type clientOptions struct {
-	retries   int
-	retryWait time.Duration
-	region    apiRegion
+	retries          int
+	retryWait        time.Duration
+	retryPolicy      RetryPolicy
+	tokenProvider    TokenProvider
+	maxRetryWait     *time.Duration
+	maxRetryDuration time.Duration
+	region           apiRegion
+	regionGuard      bool
+	onBackoff        BackoffFunc
+	statsWindow      int
+	maxResponseSize  int64
+	logger           Logger
+	logLevels        *logLevels
+	debug            io.Writer
+	metrics          MetricsCollector
+	requestHooks     []RequestHook
+	transport        http.RoundTripper
+	tlsConfig        *tls.Config
+	timeout          *time.Duration
+	baseURL          string
+	apiVersion       string
+	appName          string
+	appVersion       string
+	headers          http.Header
+	documentCheck    bool
+	chunkSize        int64
 }
 
 func WithRetries(retries int, wait time.Duration) ClientOption {
@@ -239,6 +475,171 @@ func WithRetries(retries int, wait time.Duration) ClientOption {
 	}
 }
 
+// WithMaxRetryWait caps the wait before each retry, including the waits asked by the Retry-After
+// header of rate-limited responses and the delays of a [RetryPolicy] (1 minute by default).
+// A wait of 0 removes the limit.
+func WithMaxRetryWait(wait time.Duration) ClientOption {
+	return func(c *clientOptions) {
+		c.maxRetryWait = &wait
+	}
+}
+
+// WithMaxRetryDuration bounds the total time spent on a call and its retries, whatever the number
+// of retries allowed: no retry is made once it would start past the duration, the last response or
+// error is returned instead. This keeps a single call from blocking a worker for minutes during an outage.
+func WithMaxRetryDuration(d time.Duration) ClientOption {
+	return func(c *clientOptions) {
+		c.maxRetryDuration = d
+	}
+}
+
+// BackoffFunc is called right before the client sleeps between two attempts of a request.
+//
+// attempt is the number of the retry about to be made (starting at 1), wait is how long the
+// client is going to sleep and cause is the error or unexpected response that triggered the retry.
+type BackoffFunc func(attempt int, wait time.Duration, cause error)
+
+// WithOnBackoff registers a hook that is called before every retry wait, so applications can
+// log or export how long the client is about to sleep and why.
+func WithOnBackoff(fn BackoffFunc) ClientOption {
+	return func(c *clientOptions) {
+		c.onBackoff = fn
+	}
+}
+
+// WithEndpointStats enables in-client tracking of the success rate and p95 latency of every
+// endpoint over a rolling window of the last requests made to it.
+//
+// The stats can be polled with [Client.EndpointStats], e.g. to feed error-budget dashboards.
+func WithEndpointStats(window int) ClientOption {
+	return func(c *clientOptions) {
+		c.statsWindow = window
+	}
+}
+
+// WithDocumentCompatibilityCheck rejects, before calling the API, the document uploads whose type,
+// side and issuing country are known not to go together, see [UploadDocumentPayload.CheckCompatibility].
+func WithDocumentCompatibilityCheck() ClientOption {
+	return func(c *clientOptions) {
+		c.documentCheck = true
+	}
+}
+
+// WithMaxResponseSize caps the size, in bytes, of the API responses buffered in memory so that an
+// unexpectedly large payload can't exhaust memory. Responses above the limit fail with [ErrResponseTooLarge].
+//
+// File downloads are streamed and are not subject to the limit.
+func WithMaxResponseSize(size int64) ClientOption {
+	return func(c *clientOptions) {
+		c.maxResponseSize = size
+	}
+}
+
+// Logger receives the internal diagnostics of the client: the method, path, status, duration and
+// attempts of every request, and the retried requests.
+//
+// It is satisfied by *slog.Logger, nothing is logged unless a logger is set with [WithLogger].
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+// WithAppInfo identifies the application using the client in the User-Agent of its requests,
+// e.g. "Go-Onfido/1.0.0 kyc-service/2.3.1", so that its traffic can be attributed in gateway logs and by Onfido support
+func WithAppInfo(name, version string) ClientOption {
+	return func(c *clientOptions) {
+		c.appName = name
+		c.appVersion = version
+	}
+}
+
+// WithDefaultHeaders adds headers to every request of the client, e.g. internal routing headers or
+// cost-attribution tags. They can't override the Authorization, Content-Type and User-Agent headers.
+//
+// The headers of multiple calls are merged, headers set per call with [WithCallHeader] take precedence.
+func WithDefaultHeaders(headers http.Header) ClientOption {
+	return func(c *clientOptions) {
+		if c.headers == nil {
+			c.headers = make(http.Header)
+		}
+		for k, v := range headers {
+			c.headers[http.CanonicalHeaderKey(k)] = append([]string(nil), v...)
+		}
+	}
+}
+
+// WithLogger sets the logger receiving the internal diagnostics of the client
+func WithLogger(logger Logger) ClientOption {
+	return func(c *clientOptions) {
+		c.logger = logger
+	}
+}
+
+type logLevels struct {
+	request slog.Level
+	retry   slog.Level
+}
+
+// WithLogLevels sets the levels at which completed requests (debug by default) and retries
+// (warn by default) are logged. Requests failing without a response are always logged as errors.
+func WithLogLevels(request, retry slog.Level) ClientOption {
+	return func(c *clientOptions) {
+		c.logLevels = &logLevels{request: request, retry: retry}
+	}
+}
+
+// WithTransport sets the [http.RoundTripper] used to send requests, e.g. to wrap the default
+// transport for caching, instrumentation or test interception.
+//
+// The transport also carries file downloads.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *clientOptions) {
+		c.transport = transport
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the connections to the API, e.g. to trust the private CA
+// of a TLS-intercepting egress proxy or to present a client certificate to a mutual TLS gateway.
+//
+// It applies to the default transport, or to the transport set with [WithTransport] if it is an *http.Transport.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *clientOptions) {
+		c.tlsConfig = config
+	}
+}
+
+// httpTransport returns the transport of the client, nil for the default transport
+func (o *clientOptions) httpTransport() (http.RoundTripper, error) {
+	if o.tlsConfig == nil {
+		return o.transport, nil
+	}
+
+	base := http.DefaultTransport
+	if o.transport != nil {
+		base = o.transport
+	}
+	transport, ok := base.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("tls config requires the transport to be an *http.Transport, got %T", base)
+	}
+
+	transport = transport.Clone()
+	transport.TLSClientConfig = o.tlsConfig
+	return transport, nil
+}
+
+// WithTimeout sets the time limit of every attempt of a request, 30 seconds by default.
+//
+// The limit includes reading the response body, so it also bounds file downloads:
+// raise it for large downloads, or set it to 0 to disable it and rely on contexts instead.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *clientOptions) {
+		c.timeout = &timeout
+	}
+}
+
 type apiRegion string
 
 const (
@@ -256,6 +657,103 @@ func WithRegion(region apiRegion) ClientOption {
 	}
 }
 
+// WithBaseURL overrides the host the client sends requests to, e.g. a mock server, an API gateway
+// alias or a dedicated sandbox hostname. The API version is still appended to it:
+//
+//	client, err := onfido.NewClient(token, onfido.WithBaseURL("http://localhost:8080"))
+//	// requests are sent to http://localhost:8080/v3.6/...
+//
+// The region is still used by the region guard.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *clientOptions) {
+		c.baseURL = baseURL
+	}
+}
+
+// apiVersionPattern matches the API versions accepted by [WithAPIVersion], e.g. v3.6
+var apiVersionPattern = regexp.MustCompile(`^v\d+(\.\d+)?$`)
+
+// WithAPIVersion pins the client to another version of the Onfido API than [LATEST_API_VERSION].
+//
+// The models of the SDK follow the latest version, fields that differ in other versions may not be decoded.
+func WithAPIVersion(version string) ClientOption {
+	return func(c *clientOptions) {
+		c.apiVersion = version
+	}
+}
+
+// WithRegionGuard enables the data-residency guard.
+//
+// The region of the API token is inferred from its prefix (api_live_us, api_sandbox_ca, ...,
+// tokens without a region suffix belong to the EU region). When it does not match the configured
+// region, or cannot be inferred, every call is refused with a [RegionMismatchError] instead of
+// sending data across regions.
+func WithRegionGuard() ClientOption {
+	return func(c *clientOptions) {
+		c.regionGuard = true
+	}
+}
+
+// checkTokenRegion returns a [RegionMismatchError] unless the token belongs to region
+func checkTokenRegion(token string, region apiRegion) error {
+	if tokenRegion, ok := inferTokenRegion(token); !ok || tokenRegion != region {
+		return &RegionMismatchError{Region: region, TokenRegion: tokenRegion}
+	}
+	return nil
+}
+
+// TokenProvider returns the API token to authenticate a request with, e.g. from a secret store,
+// so that the token can be rotated without recreating the client.
+//
+// It is called before every request and should cache the token rather than fetch it every time.
+type TokenProvider func(ctx context.Context) (string, error)
+
+// WithTokenProvider authenticates the requests with the token returned by provider instead of the
+// static token given to [NewClient]. When the region guard is enabled, every token returned is checked.
+func WithTokenProvider(provider TokenProvider) ClientOption {
+	return func(c *clientOptions) {
+		c.tokenProvider = provider
+	}
+}
+
+// tokenAuthorization returns the Authorization header of the requests from the tokens of provider
+func tokenAuthorization(provider TokenProvider, region apiRegion, regionGuard bool) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		token, err := provider(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to get api token: %w", err)
+		}
+		if token == "" {
+			return "", errors.New("failed to get api token: token provider returned an empty token")
+		}
+		if regionGuard {
+			if err := checkTokenRegion(token, region); err != nil {
+				return "", err
+			}
+		}
+		return "Token token=" + token, nil
+	}
+}
+
+// inferTokenRegion infers the region of an API token from its prefix
+func inferTokenRegion(token string) (apiRegion, bool) {
+	prefix, _, found := strings.Cut(token, ".")
+	if !found {
+		return "", false
+	}
+
+	switch prefix {
+	case "api_live", "api_sandbox":
+		return API_REGION_EU, true
+	case "api_live_us", "api_sandbox_us":
+		return API_REGION_US, true
+	case "api_live_ca", "api_sandbox_ca":
+		return API_REGION_CA, true
+	}
+
+	return "", false
+}
+
 // ------------------------------------------------------------------
 //                              PAGINATION
 // ------------------------------------------------------------------
@@ -284,6 +782,16 @@ func (PaginationOption) isListApplicantOption() {}
 
 func (PaginationOption) isListWorkflowRunOption() {}
 
+func (PaginationOption) isListCheckOption() {}
+
+func (PaginationOption) isListLivePhotoOption() {}
+
+func (PaginationOption) isListLiveVideoOption() {}
+
+func (PaginationOption) isListMotionCaptureOption() {}
+
+func (PaginationOption) isListIDPhotoOption() {}
+
 type paginationOption struct {
 	Page int `json:"page"`
 }
@@ -300,6 +808,16 @@ type LimitPaginationOption func(*limitPaginationOption)
 
 func (LimitPaginationOption) isListApplicantOption() {}
 
+func (LimitPaginationOption) isListCheckOption() {}
+
+func (LimitPaginationOption) isListLivePhotoOption() {}
+
+func (LimitPaginationOption) isListLiveVideoOption() {}
+
+func (LimitPaginationOption) isListMotionCaptureOption() {}
+
+func (LimitPaginationOption) isListIDPhotoOption() {}
+
 type limitPaginationOption struct {
 	PerPage int `json:"per_page"`
 }
@@ -343,38 +861,23 @@ func (c Client) extractPageDetails(headers http.Header) PageDetails {
 		pageResponse.Total = &total
 	}
 
-	links := strings.Split(headers.Get("Link"), ",")
-	for _, link := range links {
-		splitted := strings.Split(link, ">; rel=")
-		if len(splitted) != 2 {
+	for _, link := range utils.ParseLinkHeader(headers.Values("Link")...) {
+		linkURL, err := url.Parse(link.URL)
+		if err != nil {
 			continue
 		}
-		main, rel := splitted[0], strings.ReplaceAll(splitted[1], "\"", "")
-
-		page, per_page := 0, 0
+		query := linkURL.Query()
 
-		// Check if the main string contains "per_page=" and extract the value
-		if strings.Contains(main, "per_page=") {
-			splittedMain := strings.Split(main, "&per_page=")
-			if len(splittedMain) != 2 {
-				continue
-			}
-			per_page, _ = strconv.Atoi(splittedMain[1])
-			main = splittedMain[0]
+		if perPage, _ := strconv.Atoi(query.Get("per_page")); perPage != 0 {
+			pageResponse.Limit = &perPage
 		}
 
-		if per_page != 0 {
-			pageResponse.Limit = &per_page
-		}
-
-		// extract the page number
-		splittedMain := strings.Split(main, "page=")
-		if len(splittedMain) != 2 {
+		page, _ := strconv.Atoi(query.Get("page"))
+		if page == 0 {
 			continue
 		}
-		page, _ = strconv.Atoi(splittedMain[1])
 
-		if page != 0 {
+		for _, rel := range link.Rels {
 			switch rel {
 			case "first":
 				pageResponse.FirstPage = &page
@@ -386,12 +889,42 @@ func (c Client) extractPageDetails(headers http.Header) PageDetails {
 				pageResponse.PrevPage = &page
 			}
 		}
-
 	}
 
 	return pageResponse
 }
 
+// defaultPageLimit is the number of items per page returned by Onfido when none is requested
+const defaultPageLimit = 20
+
+// checkPageRange returns a [PageOutOfRangeError] when an empty page past the end of a non-empty list was returned,
+// so that callers can tell "no data" apart from "past the end"
+func (c Client) checkPageRange(params map[string]string, count int, details PageDetails) error {
+	if count > 0 || details.Total == nil {
+		return nil
+	}
+
+	page, _ := strconv.Atoi(params["page"])
+	if page <= 1 {
+		return nil
+	}
+
+	limit, _ := strconv.Atoi(params["per_page"])
+	if limit == 0 && details.Limit != nil {
+		limit = *details.Limit
+	}
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+
+	lastPage := (*details.Total + limit - 1) / limit
+	if page <= lastPage {
+		return nil
+	}
+
+	return &PageOutOfRangeError{Page: page, LastPage: lastPage}
+}
+
 var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
 
 func escapeQuotes(s string) string {
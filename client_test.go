@@ -1,6 +1,16 @@
 package onfido_test
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +21,145 @@ import (
 func TestClient(t *testing.T) {
 	t.Run("NewClient", testNewClient)
 	t.Run("ClientClose", testClientClose)
+	t.Run("RegionGuard", testRegionGuard)
+	t.Run("Transport", testTransport)
+	t.Run("Timeout", testTimeout)
+	t.Run("CallOptions", testCallOptions)
+	t.Run("BaseURL", testBaseURL)
+	t.Run("APIVersion", testAPIVersion)
+	t.Run("TokenProvider", testTokenProvider)
+	t.Run("TLSConfig", testTLSConfig)
+	t.Run("AppInfo", testAppInfo)
+	t.Run("DefaultHeaders", testDefaultHeaders)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func testTransport(t *testing.T) {
+	t.Run("SendRequestsThroughTransport", func(t *testing.T) {
+		var paths []string
+		transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			paths = append(paths, req.URL.Path)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"id":"applicant-id"}`)),
+				Request:    req,
+			}, nil
+		})
+
+		client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		applicant, err := client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+		assert.Equal(t, "applicant-id", applicant.ID)
+		assert.Equal(t, []string{"/v3.6/applicants/applicant-id"}, paths, "expected request to go through the transport")
+	})
+}
+
+func testCallOptions(t *testing.T) {
+	var lastReq *http.Request
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		lastReq = req
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"applicant-id"}`)),
+			Request:    req,
+		}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	t.Run("ApplyHeadersAndQueryParams", func(t *testing.T) {
+		ctx := onfido.ContextWithCallOptions(context.Background(), onfido.WithCallHeader("X-Trace", "trace-id"))
+		ctx = onfido.ContextWithCallOptions(ctx, onfido.WithCallQueryParam("expand", "consents"))
+
+		_, err := client.RetrieveApplicant(ctx, "applicant-id")
+		assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+		assert.Equal(t, "trace-id", lastReq.Header.Get("X-Trace"), "expected call header to be sent")
+		assert.Equal(t, "consents", lastReq.URL.Query().Get("expand"), "expected call query param to be sent")
+	})
+
+	t.Run("OverrideMethodQueryParams", func(t *testing.T) {
+		var query url.Values
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"applicants":[]}`))
+		}))
+		defer server.Close()
+
+		client, teardown, err := setupClient("token", onfido.WithBaseURL(server.URL+"/"))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		ctx := onfido.ContextWithCallOptions(context.Background(), onfido.WithCallQueryParam("page", "3"))
+
+		_, _, err = client.ListApplicants(ctx, onfido.WithPage(2))
+		assert.NoErrorf(t, err, expectedNoError, "ListApplicants", err)
+		assert.Equal(t, []string{"3"}, query["page"], "expected the call query param to override the method one")
+	})
+
+	t.Run("ApplyToCreateRequests", func(t *testing.T) {
+		ctx := onfido.ContextWithCallOptions(context.Background(), onfido.WithCallHeader("X-Trace", "create-trace"))
+
+		_, err := client.CreateApplicant(ctx, onfido.CreateApplicantPayload{FirstName: "John", LastName: "Doe"})
+		assert.NoErrorf(t, err, expectedNoError, "CreateApplicant", err)
+		assert.Equal(t, "create-trace", lastReq.Header.Get("X-Trace"), "expected call header to be sent")
+	})
+
+	t.Run("LeaveOtherCallsUntouched", func(t *testing.T) {
+		_, err := client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+		assert.Empty(t, lastReq.Header.Get("X-Trace"), "expected no call header")
+		assert.Empty(t, lastReq.URL.RawQuery, "expected no call query params")
+	})
+
+	t.Run("BoundCallWithTimeout", func(t *testing.T) {
+		ctx := onfido.ContextWithCallOptions(context.Background(), onfido.WithCallTimeout(5*time.Second))
+
+		_, err := client.RetrieveApplicant(ctx, "applicant-id")
+		assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+		deadline, ok := lastReq.Context().Deadline()
+		assert.True(t, ok, "expected request to carry a deadline")
+		assert.WithinDuration(t, time.Now().Add(5*time.Second), deadline, time.Second)
+	})
+
+	t.Run("BoundRetriesWithTimeout", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "3")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		client, teardown, err := setupClient("token", onfido.WithBaseURL(server.URL+"/"), onfido.WithRetries(3, time.Second))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		ctx := onfido.ContextWithCallOptions(context.Background(), onfido.WithCallTimeout(200*time.Millisecond))
+
+		start := time.Now()
+		_, err = client.RetrieveApplicant(ctx, "applicant-id")
+		assert.ErrorIsf(t, err, context.DeadlineExceeded, expectedError, "RetrieveApplicant", err)
+		assert.Less(t, time.Since(start), time.Second, "expected the call to return once its timeout is reached")
+	})
 }
 
 func testNewClient(t *testing.T) {
@@ -50,3 +199,345 @@ func testClientClose(t *testing.T) {
 		teardown()
 	})
 }
+
+func testRegionGuard(t *testing.T) {
+	t.Run("RefuseCallsOnRegionMismatch", func(t *testing.T) {
+		client, teardown, _ := setupClient("api_live_us.token", onfido.WithRegion(onfido.API_REGION_EU), onfido.WithRegionGuard())
+		defer teardown()
+
+		_, err := client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.Truef(t, errors.Is(err, onfido.ErrRegionMismatch), "expected region mismatch error. got %v", err)
+
+		var mismatch *onfido.RegionMismatchError
+		if assert.ErrorAs(t, err, &mismatch, "expected error to be a RegionMismatchError") {
+			assert.Equal(t, onfido.API_REGION_US, mismatch.TokenRegion, "expected token region to be US")
+			assert.Equal(t, onfido.API_REGION_EU, mismatch.Region, "expected region to be EU")
+		}
+	})
+
+	t.Run("RefuseCallsOnUnknownTokenRegion", func(t *testing.T) {
+		client, teardown, _ := setupClient("token", onfido.WithRegionGuard())
+		defer teardown()
+
+		_, err := client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.Truef(t, errors.Is(err, onfido.ErrRegionMismatch), "expected region mismatch error. got %v", err)
+	})
+}
+
+func testBaseURL(t *testing.T) {
+	t.Run("SendRequestsToBaseURL", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v3.6/applicants/applicant-id", r.URL.Path, "expected API version to be appended to the base URL")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"applicant-id"}`))
+		}))
+		defer server.Close()
+
+		client, teardown, err := setupClient("token", onfido.WithBaseURL(server.URL+"/"))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		assert.Equal(t, server.URL+"/v3.6", client.Endpoint, "endpoint should be derived from the base URL")
+
+		applicant, err := client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+		assert.Equal(t, "applicant-id", applicant.ID)
+	})
+
+	t.Run("ReturnErrorOnRelativeURL", func(t *testing.T) {
+		_, _, err := setupClient("token", onfido.WithBaseURL("api.example.com"))
+		assert.Error(t, err, "error should not be nil")
+	})
+}
+
+func testAPIVersion(t *testing.T) {
+	t.Run("SetVersionSuccessfully", func(t *testing.T) {
+		client, teardown, err := setupClient("token", onfido.WithAPIVersion("v3.5"))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		assert.Equal(t, "v3.5", client.APIVersion, "API version should be set")
+		assert.Equal(t, "https://api.eu.onfido.com/v3.5", client.Endpoint, "endpoint should use the API version")
+	})
+
+	t.Run("DefaultToLatestVersion", func(t *testing.T) {
+		client, teardown, _ := setupClient("token")
+		defer teardown()
+
+		assert.Equal(t, onfido.LATEST_API_VERSION, client.APIVersion, "API version should default to the latest")
+	})
+
+	t.Run("ReturnErrorOnInvalidVersion", func(t *testing.T) {
+		_, _, err := setupClient("token", onfido.WithAPIVersion("3.5/applicants"))
+		assert.Error(t, err, "error should not be nil")
+	})
+}
+
+func testTokenProvider(t *testing.T) {
+	var authorizations []string
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		authorizations = append(authorizations, req.Header.Get("Authorization"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"applicant-id"}`)),
+			Request:    req,
+		}, nil
+	})
+
+	t.Run("AuthenticateWithRotatedTokens", func(t *testing.T) {
+		authorizations = nil
+		tokens := []string{"api_live.first", "api_live.second"}
+		provider := func(ctx context.Context) (string, error) {
+			token := tokens[0]
+			tokens = tokens[1:]
+			return token, nil
+		}
+
+		client, teardown, err := setupClient("", onfido.WithTransport(transport), onfido.WithTokenProvider(provider))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		for i := 0; i < 2; i++ {
+			_, err := client.RetrieveApplicant(context.Background(), "applicant-id")
+			assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+		}
+		assert.Equal(t, []string{"Token token=api_live.first", "Token token=api_live.second"}, authorizations)
+	})
+
+	t.Run("ReturnProviderErrors", func(t *testing.T) {
+		authorizations = nil
+		errVault := errors.New("vault unavailable")
+		provider := func(ctx context.Context) (string, error) { return "", errVault }
+
+		client, teardown, err := setupClient("", onfido.WithTransport(transport), onfido.WithTokenProvider(provider))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.ErrorIs(t, err, errVault)
+		assert.Empty(t, authorizations, "expected no request to be sent")
+	})
+
+	t.Run("GuardRegionOfProvidedTokens", func(t *testing.T) {
+		provider := func(ctx context.Context) (string, error) { return "api_live_us.token", nil }
+
+		client, teardown, err := setupClient("", onfido.WithTransport(transport), onfido.WithTokenProvider(provider), onfido.WithRegionGuard())
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.ErrorIs(t, err, onfido.ErrRegionMismatch)
+	})
+}
+
+func testTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"applicant-id"}`))
+	}))
+	defer server.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+
+	t.Run("TrustCustomCA", func(t *testing.T) {
+		client, teardown, err := setupClient("token", onfido.WithBaseURL(server.URL), onfido.WithTLSConfig(&tls.Config{RootCAs: roots}))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+	})
+
+	t.Run("RejectUnknownCAByDefault", func(t *testing.T) {
+		client, teardown, err := setupClient("token", onfido.WithBaseURL(server.URL))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.Errorf(t, err, expectedError, "RetrieveApplicant", err)
+	})
+
+	t.Run("ReturnErrorOnCustomRoundTripper", func(t *testing.T) {
+		transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) { return nil, nil })
+		_, _, err := setupClient("token", onfido.WithTransport(transport), onfido.WithTLSConfig(&tls.Config{RootCAs: roots}))
+		assert.Error(t, err, "error should not be nil")
+	})
+}
+
+func testAppInfo(t *testing.T) {
+	var userAgent string
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		userAgent = req.Header.Get("User-Agent")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"applicant-id"}`)),
+			Request:    req,
+		}, nil
+	})
+
+	tests := []struct {
+		name   string
+		opts   []onfido.ClientOption
+		wantUA string
+	}{
+		{name: "AppendAppInfo", opts: []onfido.ClientOption{onfido.WithAppInfo("kyc-service", "2.3.1")}, wantUA: "Go-Onfido/" + onfido.CURRENT_CLIENT_VERSION + " kyc-service/2.3.1"},
+		{name: "AppendAppNameWithoutVersion", opts: []onfido.ClientOption{onfido.WithAppInfo("kyc-service", "")}, wantUA: "Go-Onfido/" + onfido.CURRENT_CLIENT_VERSION + " kyc-service"},
+		{name: "KeepDefaultUserAgent", wantUA: "Go-Onfido/" + onfido.CURRENT_CLIENT_VERSION},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, teardown, err := setupClient("token", append(tt.opts, onfido.WithTransport(transport))...)
+			if err != nil {
+				t.Fatalf("error setting up client: %v", err)
+			}
+			defer teardown()
+
+			_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+			assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+			assert.Equal(t, tt.wantUA, userAgent)
+		})
+	}
+}
+
+func testDefaultHeaders(t *testing.T) {
+	var headers []http.Header
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		headers = append(headers, req.Header.Clone())
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"applicant-id"}`)),
+			Request:    req,
+		}, nil
+	})
+
+	client, teardown, err := setupClient("token",
+		onfido.WithTransport(transport),
+		onfido.WithDefaultHeaders(http.Header{"X-Cost-Center": {"kyc"}, "Authorization": {"Bearer other"}}),
+		onfido.WithDefaultHeaders(http.Header{"x-route": {"eu-1"}}),
+	)
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+	assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+
+	ctx := onfido.ContextWithCallOptions(context.Background(), onfido.WithCallHeader("X-Route", "eu-2"))
+	_, err = client.RetrieveApplicant(ctx, "applicant-id")
+	assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+
+	if assert.Len(t, headers, 2, "expected 2 requests") {
+		assert.Equal(t, "kyc", headers[0].Get("X-Cost-Center"), "expected default header to be sent")
+		assert.Equal(t, "eu-1", headers[0].Get("X-Route"), "expected headers of every option to be merged")
+		assert.Equal(t, "Token token=token", headers[0].Get("Authorization"), "expected default headers not to override authorization")
+		assert.Equal(t, "eu-2", headers[1].Get("X-Route"), "expected call header to take precedence")
+	}
+}
+
+func testTimeout(t *testing.T) {
+	t.Run("AbortSlowRequests", func(t *testing.T) {
+		transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		})
+
+		client, teardown, err := setupClient("token", onfido.WithTransport(transport), onfido.WithTimeout(10*time.Millisecond))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		start := time.Now()
+		_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.Errorf(t, err, expectedError, "RetrieveApplicant", err)
+		assert.Less(t, time.Since(start), time.Second, "expected request to be aborted by the timeout")
+	})
+}
+
+func TestRawJSON(t *testing.T) {
+	responses := map[string]string{
+		"/v3.6/applicants/applicant-1": `{"id":"applicant-1","first_name":"John","risk_tier":"low"}`,
+		"/v3.6/documents":              `{"documents":[{"id":"document-1","type":"passport","page_count":2},{"id":"document-2","type":"passport"}]}`,
+		"/v3.6/reports/report-1":       `{"id":"report-1","name":"document","breakdown":{"data_comparison":{"result":"clear"}},"sub_breakdown_version":2}`,
+	}
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body := responses[req.URL.Path]
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	t.Run("KeepResourceJSON", func(t *testing.T) {
+		applicant, err := client.RetrieveApplicant(context.Background(), "applicant-1")
+		if !assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err) {
+			return
+		}
+		assert.Equal(t, "John", applicant.FirstName)
+		assert.JSONEq(t, responses["/v3.6/applicants/applicant-1"], string(applicant.Raw))
+
+		var unmodelled struct {
+			RiskTier string `json:"risk_tier"`
+		}
+		assert.NoError(t, json.Unmarshal(applicant.Raw, &unmodelled))
+		assert.Equal(t, "low", unmodelled.RiskTier, "expected unmodelled fields to be readable")
+	})
+
+	t.Run("KeepListItemJSON", func(t *testing.T) {
+		documents, _, err := client.ListDocuments(context.Background(), "applicant-1")
+		if !assert.NoErrorf(t, err, expectedNoError, "ListDocuments", err) || !assert.Len(t, documents, 2) {
+			return
+		}
+		assert.JSONEq(t, `{"id":"document-1","type":"passport","page_count":2}`, string(documents[0].Raw))
+		assert.JSONEq(t, `{"id":"document-2","type":"passport"}`, string(documents[1].Raw))
+	})
+
+	t.Run("KeepReportJSON", func(t *testing.T) {
+		report, err := client.RetrieveReport(context.Background(), "report-1")
+		if !assert.NoErrorf(t, err, expectedNoError, "RetrieveReport", err) {
+			return
+		}
+		assert.JSONEq(t, responses["/v3.6/reports/report-1"], string(report.Raw))
+
+		documentReport, err := report.AsDocumentReport()
+		if assert.NoErrorf(t, err, expectedNoError, "AsDocumentReport", err) {
+			assert.NotNil(t, documentReport.Breakdown.DataComparison, "expected the breakdown to be typed")
+			assert.JSONEq(t, responses["/v3.6/reports/report-1"], string(documentReport.Raw))
+		}
+
+		var decoded onfido.DocumentReport
+		if assert.NoError(t, json.Unmarshal([]byte(responses["/v3.6/reports/report-1"]), &decoded)) {
+			assert.Equal(t, "report-1", decoded.ID)
+			assert.NotNil(t, decoded.Breakdown.DataComparison, "expected the breakdown to be typed")
+		}
+	})
+
+	t.Run("OmitRawWhenEncoding", func(t *testing.T) {
+		encoded, err := json.Marshal(onfido.Applicant{ID: "applicant-1", Raw: json.RawMessage(`{"id":"other"}`)})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id":"applicant-1"}`, string(encoded))
+	})
+}
@@ -0,0 +1,214 @@
+package onfido
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ------------------------------------------------------------------
+//                              DEBUG
+// ------------------------------------------------------------------
+
+const redacted = "[REDACTED]"
+
+// redactedHeaders are the headers whose values are never dumped
+var redactedHeaders = map[string]bool{
+	"Authorization": true,
+	"Cookie":        true,
+	"Set-Cookie":    true,
+}
+
+// redactedFields are the JSON fields and query parameters holding credentials or personal data, their values are never dumped
+var redactedFields = map[string]bool{
+	"token":            true,
+	"first_name":       true,
+	"middle_name":      true,
+	"last_name":        true,
+	"full_name":        true,
+	"email":            true,
+	"phone_number":     true,
+	"dob":              true,
+	"date_of_birth":    true,
+	"address":          true,
+	"addresses":        true,
+	"postcode":         true,
+	"id_numbers":       true,
+	"document_numbers": true,
+	"document_number":  true,
+	"personal_number":  true,
+	"mrz_line1":        true,
+	"mrz_line2":        true,
+	"mrz_line3":        true,
+}
+
+// WithDebug dumps every HTTP request and response of the client to w for troubleshooting.
+//
+// Credentials and personal data (names, dates of birth, addresses, document numbers, ...) are
+// redacted, and only JSON bodies are dumped: file uploads and downloads are summarized.
+func WithDebug(w io.Writer) ClientOption {
+	return func(c *clientOptions) {
+		c.debug = w
+	}
+}
+
+// debugTransport dumps the requests it carries with their responses
+type debugTransport struct {
+	mu   sync.Mutex
+	w    io.Writer
+	next http.RoundTripper
+}
+
+func newDebugTransport(w io.Writer, next http.RoundTripper) *debugTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &debugTransport{w: w, next: next}
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqURL := redactURL(req.URL)
+
+	var dump bytes.Buffer
+	fmt.Fprintf(&dump, "--> %s %s\n", req.Method, reqURL)
+	dumpHeaders(&dump, req.Header)
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		// the request of the caller is left untouched, the body read is sent with a copy of it
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		dumpBody(&dump, req.Header.Get("Content-Type"), body)
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		fmt.Fprintf(&dump, "<-- %s %s failed after %s: %v\n\n", req.Method, reqURL, time.Since(start), err)
+		t.write(dump.Bytes())
+		return nil, err
+	}
+
+	fmt.Fprintf(&dump, "<-- %s %s %s (%s)\n", resp.Status, req.Method, reqURL, time.Since(start))
+	dumpHeaders(&dump, resp.Header)
+
+	// only JSON responses are read, downloads keep being streamed to the caller
+	contentType := resp.Header.Get("Content-Type")
+	if isJSONContent(contentType) {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		dumpBody(&dump, contentType, body)
+	} else if contentType != "" {
+		fmt.Fprintf(&dump, "\n[%s body not dumped]\n", contentType)
+	}
+
+	dump.WriteString("\n")
+	t.write(dump.Bytes())
+	return resp, nil
+}
+
+// write writes a dump at once, so that the dumps of concurrent requests don't interleave
+func (t *debugTransport) write(dump []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.w.Write(dump)
+}
+
+// redactURL returns the URL with the values of its redacted query parameters replaced
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	if len(query) == 0 {
+		return u.String()
+	}
+
+	for key, values := range query {
+		if redactedFields[key] {
+			for i := range values {
+				values[i] = redacted
+			}
+		}
+	}
+
+	redactedURL := *u
+	redactedURL.RawQuery = strings.ReplaceAll(query.Encode(), url.QueryEscape(redacted), redacted)
+	return redactedURL.String()
+}
+
+func dumpHeaders(w io.Writer, headers http.Header) {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		value := strings.Join(headers[k], ", ")
+		if redactedHeaders[http.CanonicalHeaderKey(k)] {
+			value = redacted
+		}
+		fmt.Fprintf(w, "%s: %s\n", k, value)
+	}
+}
+
+func dumpBody(w io.Writer, contentType string, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+
+	if !isJSONContent(contentType) {
+		fmt.Fprintf(w, "\n[%d bytes of %s not dumped]\n", len(body), contentType)
+		return
+	}
+
+	var payload any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		fmt.Fprintf(w, "\n[%d bytes of invalid JSON not dumped]\n", len(body))
+		return
+	}
+
+	redactedBody, _ := json.MarshalIndent(redactFields(payload), "", "  ")
+	fmt.Fprintf(w, "\n%s\n", redactedBody)
+}
+
+// redactFields replaces the values of the redacted fields of a decoded JSON payload
+func redactFields(payload any) any {
+	switch v := payload.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if redactedFields[key] && value != nil {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactFields(value)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redactFields(value)
+		}
+	}
+	return payload
+}
+
+func isJSONContent(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
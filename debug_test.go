@@ -0,0 +1,74 @@
+package onfido_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebug(t *testing.T) {
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		assert.Contains(t, string(body), "Jane", "expected request body to reach the transport unredacted")
+
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Status:     "201 Created",
+			Header:     http.Header{"Content-Type": {"application/json; charset=utf-8"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"applicant-id","first_name":"Jane","last_name":"Roe","dob":"1990-01-31","address":{"postcode":"S2 2DF"},"id_numbers":[{"type":"ssn","value":"123-45-6789"}]}`)),
+			Request:    req,
+		}, nil
+	})
+
+	var dump bytes.Buffer
+	client, teardown, err := setupClient("secret-token", onfido.WithTransport(transport), onfido.WithDebug(&dump))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	applicant, err := client.CreateApplicant(context.Background(), onfido.CreateApplicantPayload{FirstName: "Jane", LastName: "Roe"})
+	assert.NoErrorf(t, err, expectedNoError, "CreateApplicant", err)
+	assert.Equal(t, "Jane", applicant.FirstName, "expected response to reach the client unredacted")
+
+	output := dump.String()
+	t.Run("DumpRequestsAndResponses", func(t *testing.T) {
+		assert.Contains(t, output, "--> POST https://api.eu.onfido.com/v3.6/applicants")
+		assert.Contains(t, output, "<-- 201 Created POST")
+		assert.Contains(t, output, `"id": "applicant-id"`)
+	})
+
+	t.Run("RedactCredentialsAndPersonalData", func(t *testing.T) {
+		assert.Contains(t, output, "Authorization: [REDACTED]")
+		for _, secret := range []string{"secret-token", "Jane", "Roe", "1990-01-31", "S2 2DF", "123-45-6789"} {
+			assert.NotContainsf(t, output, secret, "expected %q to be redacted", secret)
+		}
+	})
+
+	t.Run("RedactQueryParameters", func(t *testing.T) {
+		var query string
+		transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			query = req.URL.RawQuery
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(`{"addresses":[]}`)), Request: req}, nil
+		})
+
+		var dump bytes.Buffer
+		client, teardown, err := setupClient("secret-token", onfido.WithTransport(transport), onfido.WithDebug(&dump))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.SearchAddresses(context.Background(), "S2 2DF")
+		assert.NoErrorf(t, err, expectedNoError, "SearchAddresses", err)
+		assert.Equal(t, "postcode=S2+2DF", query, "expected the query to reach the transport unredacted")
+		assert.Contains(t, dump.String(), "--> GET https://api.eu.onfido.com/v3.6/addresses/pick?postcode=[REDACTED]")
+		assert.NotContains(t, dump.String(), "S2", "expected the postcode to be redacted")
+	})
+}
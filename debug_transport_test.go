@@ -0,0 +1,40 @@
+package onfido
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// transportFunc is an http.RoundTripper calling the function
+type transportFunc func(*http.Request) (*http.Response, error)
+
+func (f transportFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestDebugTransport calls the transport directly, the request it is given is built inside the client
+// and can't be checked through the public API
+func TestDebugTransport(t *testing.T) {
+	t.Run("LeaveRequestUntouched", func(t *testing.T) {
+		var sent *http.Request
+		var sentBody string
+		transport := newDebugTransport(io.Discard, transportFunc(func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			sent, sentBody = req, string(body)
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody, Request: req}, nil
+		}))
+
+		req, _ := http.NewRequest(http.MethodPost, "https://api.eu.onfido.com/v3.6/applicants", strings.NewReader(`{"first_name":"Jane"}`))
+		body := req.Body
+
+		_, err := transport.RoundTrip(req)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"first_name":"Jane"}`, sentBody, "expected the body to be sent")
+		assert.NotSame(t, req, sent, "expected a copy of the request to be sent")
+		assert.Equal(t, body, req.Body, "expected the body of the request to be left as is")
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"time"
 )
@@ -25,6 +26,17 @@ type Document struct {
 	DownloadHref   string       `json:"download_href,omitempty"`
 	FileName       string       `json:"file_name,omitempty"`
 	FileSize       int          `json:"file_size,omitempty"`
+	Raw            RawJSON      `json:"-"`
+}
+
+func (d *Document) UnmarshalJSON(data []byte) error {
+	type document Document
+	if err := json.Unmarshal(data, (*document)(d)); err != nil {
+		return err
+	}
+
+	d.Raw = newRawJSON(data)
+	return nil
 }
 
 // DocumentType represents the type of document
@@ -40,8 +52,49 @@ const (
 	DocumentTypeWorkPermit           DocumentType = "work_permit"
 	DocumentTypeVoterID              DocumentType = "voter_id"
 	DocumentTypeTaxID                DocumentType = "tax_id"
+
+	// Other identity documents
+	DocumentTypeVisa                           DocumentType = "visa"
+	DocumentTypeServiceIDCard                  DocumentType = "service_id_card"
+	DocumentTypeSocialSecurityCard             DocumentType = "social_security_card"
+	DocumentTypeNationalInsuranceCard          DocumentType = "national_insurance_card"
+	DocumentTypeNationalHealthInsuranceCard    DocumentType = "national_health_insurance_card"
+	DocumentTypePostalIdentityCard             DocumentType = "postal_identity_card"
+	DocumentTypeProfessionalIdentificationCard DocumentType = "professional_identification_card"
+	DocumentTypeStudentID                      DocumentType = "student_id"
+	DocumentTypeVehicleRegistrationCard        DocumentType = "vehicle_registration_card"
+	DocumentTypeImmigrationStatusDocument      DocumentType = "immigration_status_document"
+	DocumentTypeCertificateOfNaturalisation    DocumentType = "certificate_of_naturalisation"
+	DocumentTypeHomeOfficeLetter               DocumentType = "home_office_letter"
+	DocumentTypeBirthCertificate               DocumentType = "birth_certificate"
+	DocumentTypeAdoptionCertificate            DocumentType = "adoption_certificate"
+	DocumentTypeMarriageCertificate            DocumentType = "marriage_certificate"
+
+	// Proof of address documents
+	DocumentTypeUtilityBill                  DocumentType = "utility_bill"
+	DocumentTypeUtilityBillElectricity       DocumentType = "utility_bill_electricity"
+	DocumentTypeUtilityBillGas               DocumentType = "utility_bill_gas"
+	DocumentTypeUtilityBillWater             DocumentType = "utility_bill_water"
+	DocumentTypeUtilityBillOther             DocumentType = "utility_bill_other"
+	DocumentTypeBankStatement                DocumentType = "bank_statement"
+	DocumentTypeBankBuildingSocietyStatement DocumentType = "bank_building_society_statement"
+	DocumentTypeCreditCardStatement          DocumentType = "credit_card_statement"
+	DocumentTypeMortgageStatement            DocumentType = "mortgage_statement"
+	DocumentTypeCouncilTax                   DocumentType = "council_tax"
+	DocumentTypeBenefitLetters               DocumentType = "benefit_letters"
 )
 
+// MaxDocumentFileSize is the size, in bytes, of the largest document file accepted by the Onfido API
+const MaxDocumentFileSize = 10 << 20
+
+// documentContentTypes are the content types of the document files accepted by the Onfido API
+var documentContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/heic":      true,
+	"application/pdf": true,
+}
+
 type DocumentSide string
 
 const (
@@ -55,7 +108,7 @@ type UploadDocumentPayload struct {
 	FileType             string       `json:"file_type,omitempty"`
 	Type                 DocumentType `json:"type,omitempty"`
 	Side                 DocumentSide `json:"side,omitempty"`
-	IssuingCountry       string       `json:"issuing_country,omitempty"`
+	IssuingCountry       CountryCode  `json:"issuing_country,omitempty"`
 	Location             *Location    `json:"location,omitempty"`
 	ValidateImageQuality bool         `json:"validate_image_quality,omitempty"`
 }
@@ -78,6 +131,81 @@ func (ud UploadDocumentPayload) toMultipartMap() (map[string]interface{}, error)
 	return um, nil
 }
 
+// validate checks the fields of the payload required by the Onfido API, except for the file
+func (ud UploadDocumentPayload) validate() error {
+	if ud.ApplicantID == "" {
+		return &ValidationError{Field: "applicant_id", Message: "applicant_id is required"}
+	}
+	if ud.Type == "" {
+		return &ValidationError{Field: "type", Message: "type is required"}
+	}
+	if ud.Side != "" && ud.Side != DocumentSideFront && ud.Side != DocumentSideBack {
+		return &ValidationError{Field: "side", Message: fmt.Sprintf("side must be %q or %q, got %q", DocumentSideFront, DocumentSideBack, ud.Side)}
+	}
+	return ud.IssuingCountry.validate("issuing_country")
+}
+
+// singleSidedDocumentTypes are the document types that have no back side
+var singleSidedDocumentTypes = map[DocumentType]bool{
+	DocumentTypePassport: true,
+}
+
+// countryRequiredDocumentTypes are the document types the issuing country can't be inferred from
+var countryRequiredDocumentTypes = map[DocumentType]bool{
+	DocumentTypeNationalIdentityCard: true,
+	DocumentTypeResidencePermit:      true,
+	DocumentTypeWorkPermit:           true,
+	DocumentTypeVoterID:              true,
+	DocumentTypeTaxID:                true,
+}
+
+// CheckCompatibility rejects the combinations of document type, side and issuing country known to be
+// refused by the Onfido API, such as the back side of a passport or a national identity card without
+// its issuing country. It is run on every upload when the client is created with [WithDocumentCompatibilityCheck].
+func (ud UploadDocumentPayload) CheckCompatibility() error {
+	if singleSidedDocumentTypes[ud.Type] && ud.Side == DocumentSideBack {
+		return &ValidationError{Field: "side", Message: fmt.Sprintf("%s documents have a single side, upload them with side %q or no side", ud.Type, DocumentSideFront)}
+	}
+	if countryRequiredDocumentTypes[ud.Type] && ud.IssuingCountry == "" {
+		return &ValidationError{Field: "issuing_country", Message: fmt.Sprintf("issuing_country is required for %s documents", ud.Type)}
+	}
+	return nil
+}
+
+// validateDocumentFile checks the size and the content type of a document file
+func validateDocumentFile(data []byte) error {
+	if len(data) == 0 {
+		return ErrInvalidFile
+	}
+	if len(data) > MaxDocumentFileSize {
+		return documentFileTooLargeError(int64(len(data)))
+	}
+	if contentType := detectContentType(data); !documentContentTypes[contentType] {
+		return &ValidationError{Field: "file", Message: fmt.Sprintf("file must be a JPEG, PNG, HEIC or PDF file, got %s", contentType)}
+	}
+	return nil
+}
+
+func documentFileTooLargeError(size int64) error {
+	return &ValidationError{Field: "file", Message: fmt.Sprintf("file must be at most %d bytes, got %d", MaxDocumentFileSize, size)}
+}
+
+// uploadDocumentBytesPayload is an UploadDocumentPayload whose file is held in memory
+type uploadDocumentBytesPayload struct {
+	UploadDocumentPayload
+	file multipartFile
+}
+
+func (ud uploadDocumentBytesPayload) toMultipartMap() (map[string]interface{}, error) {
+	um, err := ud.UploadDocumentPayload.toMultipartMap()
+	if err != nil {
+		return nil, err
+	}
+
+	um["file"] = ud.file
+	return um, nil
+}
+
 // ------------------------------------------------------------------
 //                              OPTIONS
 // ------------------------------------------------------------------
@@ -86,8 +214,57 @@ func (ud UploadDocumentPayload) toMultipartMap() (map[string]interface{}, error)
 //                              METHODS
 // ------------------------------------------------------------------
 
-// UploadDocument uploads a document to the Onfido API
+// UploadDocument uploads a document to the Onfido API.
+//
+// The payload and the file are validated before being sent, see [Client.UploadDocumentFromBytes].
 func (c *Client) UploadDocument(ctx context.Context, payload UploadDocumentPayload) (*Document, error) {
+	if err := payload.validate(); err != nil {
+		return nil, err
+	}
+	if payload.File == nil {
+		return nil, ErrInvalidFile
+	}
+
+	// don't read a file too large to be accepted
+	if info, err := payload.File.Stat(); err == nil && info.Size() > MaxDocumentFileSize {
+		return nil, documentFileTooLargeError(info.Size())
+	}
+
+	data, err := io.ReadAll(payload.File)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", payload.File.Name(), err)
+	}
+
+	return c.UploadDocumentFromBytes(ctx, payload, data, payload.File.Name())
+}
+
+// UploadDocumentFromBytes uploads a document held in memory to the Onfido API.
+//   - data is the content of the document and filename its name, the file of the payload is ignored
+//
+// A [*ValidationError] is returned without calling the API when the applicant ID or the type is missing,
+// the side is neither front nor back, or the file is empty, larger than [MaxDocumentFileSize] or not
+// a JPEG, PNG, HEIC or PDF file.
+func (c *Client) UploadDocumentFromBytes(ctx context.Context, payload UploadDocumentPayload, data []byte, filename string) (*Document, error) {
+	if err := payload.validate(); err != nil {
+		return nil, err
+	}
+	if c.docCheck {
+		if err := payload.CheckCompatibility(); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateDocumentFile(data); err != nil {
+		return nil, err
+	}
+
+	payload.File = nil
+	return c.uploadDocument(ctx, uploadDocumentBytesPayload{
+		UploadDocumentPayload: payload,
+		file:                  multipartFile{name: filename, data: data},
+	})
+}
+
+func (c *Client) uploadDocument(ctx context.Context, payload isMultipartPayload) (*Document, error) {
 	var document Document
 
 	req := func() error {
@@ -96,7 +273,7 @@ func (c *Client) UploadDocument(ctx context.Context, payload UploadDocumentPaylo
 			return err
 		}
 
-		resp, err := c.client.Post(ctx, "/documents", body, c.getHttpRequestOptions(nil, nil)...)
+		resp, err := c.client.Post(ctx, "/documents", body, c.getHttpRequestOptions(ctx, nil, nil)...)
 		if err != nil {
 			return err
 		}
@@ -120,7 +297,7 @@ func (c *Client) RetrieveDocument(ctx context.Context, documentId string) (*Docu
 	var document Document
 
 	req := func() error {
-		resp, err := c.client.Get(ctx, "/documents/"+documentId, c.getHttpRequestOptions(nil, nil)...)
+		resp, err := c.client.Get(ctx, "/documents/"+documentId, c.getHttpRequestOptions(ctx, nil, nil)...)
 		if err != nil {
 			return err
 		}
@@ -142,7 +319,7 @@ func (c *Client) ListDocuments(ctx context.Context, applicantId string) ([]Docum
 
 	req := func() error {
 		params := c.getListDocumentParams(applicantId)
-		resp, err := c.client.Get(ctx, "/documents", c.getHttpRequestOptions(params, nil)...)
+		resp, err := c.client.Get(ctx, "/documents", c.getHttpRequestOptions(ctx, params, nil)...)
 		if err != nil {
 			return err
 		}
@@ -166,28 +343,24 @@ func (c *Client) ListDocuments(ctx context.Context, applicantId string) ([]Docum
 	return documents, &pageDetails, nil
 }
 
-func (c *Client) DownloadDocument(ctx context.Context, documentId string) ([]byte, error) {
+func (c *Client) DownloadDocument(ctx context.Context, documentId string) (*Download, error) {
 	if documentId == "" {
 		return nil, ErrInvalidId
 	}
 
-	var document []byte
+	var document *Download
 
 	req := func() error {
-		resp, err := c.client.Get(ctx, "/documents/"+documentId+"/download", c.getHttpRequestOptions(nil, nil)...)
+		data, err := c.download(ctx, "/documents/"+documentId+"/download")
 		if err != nil {
 			return err
 		}
 
-		if err := c.getError(resp, true); err != nil {
-			return err
-		}
-
-		if len(resp.Body) == 0 {
+		if len(data.Data) == 0 {
 			return fmt.Errorf("unable to download document")
 		}
 
-		document = resp.Body
+		document = data
 
 		return nil
 	}
@@ -199,28 +372,61 @@ func (c *Client) DownloadDocument(ctx context.Context, documentId string) ([]byt
 	return document, nil
 }
 
-func (c *Client) DownloadDocumentNFCFace(ctx context.Context, documentId string) ([]byte, error) {
+// DownloadDocumentStream downloads the file of a document from the Onfido API without buffering it, the caller must close the stream
+func (c *Client) DownloadDocumentStream(ctx context.Context, documentId string) (*DownloadStream, error) {
 	if documentId == "" {
 		return nil, ErrInvalidId
 	}
 
-	var nfcFace []byte
+	var stream *DownloadStream
 
 	req := func() error {
-		resp, err := c.client.Get(ctx, "/documents/"+documentId+"/nfc_face", c.getHttpRequestOptions(nil, nil)...)
+		body, err := c.downloadStream(ctx, "/documents/"+documentId+"/download")
 		if err != nil {
 			return err
 		}
 
-		if err := c.getError(resp, true); err != nil {
+		stream = body
+
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// DownloadDocumentTo writes the file of a document to w and returns the number of bytes written.
+//   - The download is not retried once writing to w has started
+func (c *Client) DownloadDocumentTo(ctx context.Context, documentId string, w io.Writer) (int64, error) {
+	stream, err := c.DownloadDocumentStream(ctx, documentId)
+	if err != nil {
+		return 0, err
+	}
+
+	return stream.copyTo(w)
+}
+
+func (c *Client) DownloadDocumentNFCFace(ctx context.Context, documentId string) (*Download, error) {
+	if documentId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var nfcFace *Download
+
+	req := func() error {
+		data, err := c.download(ctx, "/documents/"+documentId+"/nfc_face")
+		if err != nil {
 			return err
 		}
 
-		if len(resp.Body) == 0 {
+		if len(data.Data) == 0 {
 			return fmt.Errorf("unable to download document")
 		}
 
-		nfcFace = resp.Body
+		nfcFace = data
 
 		return nil
 	}
@@ -232,28 +438,24 @@ func (c *Client) DownloadDocumentNFCFace(ctx context.Context, documentId string)
 	return nfcFace, nil
 }
 
-func (c *Client) DownloadDocumentVideo(ctx context.Context, documentId string) ([]byte, error) {
+func (c *Client) DownloadDocumentVideo(ctx context.Context, documentId string) (*Download, error) {
 	if documentId == "" {
 		return nil, ErrInvalidId
 	}
 
-	var video []byte
+	var video *Download
 
 	req := func() error {
-		resp, err := c.client.Get(ctx, "/documents/"+documentId+"/video/download", c.getHttpRequestOptions(nil, nil)...)
+		data, err := c.resumableDownload(ctx, "/documents/"+documentId+"/video/download")
 		if err != nil {
 			return err
 		}
 
-		if err := c.getError(resp, true); err != nil {
-			return err
-		}
-
-		if len(resp.Body) == 0 {
+		if len(data.Data) == 0 {
 			return fmt.Errorf("unable to download document")
 		}
 
-		video = resp.Body
+		video = data
 
 		return nil
 	}
@@ -265,6 +467,43 @@ func (c *Client) DownloadDocumentVideo(ctx context.Context, documentId string) (
 	return video, nil
 }
 
+// DownloadDocumentVideoStream downloads the video of a document from the Onfido API without buffering it, the caller must close the stream
+func (c *Client) DownloadDocumentVideoStream(ctx context.Context, documentId string) (*DownloadStream, error) {
+	if documentId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var stream *DownloadStream
+
+	req := func() error {
+		body, err := c.resumableDownloadStream(ctx, "/documents/"+documentId+"/video/download")
+		if err != nil {
+			return err
+		}
+
+		stream = body
+
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// DownloadDocumentVideoTo writes the video of a document to w and returns the number of bytes written.
+//   - The download is not retried once writing to w has started
+func (c *Client) DownloadDocumentVideoTo(ctx context.Context, documentId string, w io.Writer) (int64, error) {
+	stream, err := c.DownloadDocumentVideoStream(ctx, documentId)
+	if err != nil {
+		return 0, err
+	}
+
+	return stream.copyTo(w)
+}
+
 func (c Client) getListDocumentParams(applicantId string) (params map[string]string) {
 	params = map[string]string{
 		"applicant_id": applicantId,
@@ -0,0 +1,207 @@
+package onfido
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ------------------------------------------------------------------
+//                          DOCUMENT REPORT
+// ------------------------------------------------------------------
+
+// DocumentReport is a report whose breakdown and properties are typed for the document reports
+type DocumentReport struct {
+	Report
+	Breakdown  *DocumentReportBreakdown  `json:"breakdown,omitempty"`
+	Properties *DocumentReportProperties `json:"properties,omitempty"`
+}
+
+// UnmarshalJSON decodes the typed breakdown and properties along with the embedded report, whose
+// own UnmarshalJSON would otherwise be the only one called
+func (r *DocumentReport) UnmarshalJSON(data []byte) error {
+	if err := r.Report.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	var typed struct {
+		Breakdown  *DocumentReportBreakdown  `json:"breakdown,omitempty"`
+		Properties *DocumentReportProperties `json:"properties,omitempty"`
+	}
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return err
+	}
+
+	r.Breakdown, r.Properties = typed.Breakdown, typed.Properties
+	return nil
+}
+
+// ReportBreakdownItem is the result of a single assertion of a report breakdown
+type ReportBreakdownItem struct {
+	Result     string         `json:"result,omitempty"`
+	Properties map[string]any `json:"properties,omitempty"`
+}
+
+// DocumentReportBreakdown is the breakdown of a document report
+type DocumentReportBreakdown struct {
+	DataComparison      *DocumentDataComparison     `json:"data_comparison,omitempty"`
+	DataValidation      *DocumentDataValidation     `json:"data_validation,omitempty"`
+	DataConsistency     *DocumentDataConsistency    `json:"data_consistency,omitempty"`
+	ImageIntegrity      *DocumentImageIntegrity     `json:"image_integrity,omitempty"`
+	VisualAuthenticity  *DocumentVisualAuthenticity `json:"visual_authenticity,omitempty"`
+	CompromisedDocument *ReportBreakdownItem        `json:"compromised_document,omitempty"`
+	PoliceRecord        *ReportBreakdownItem        `json:"police_record,omitempty"`
+	AgeValidation       *DocumentAgeValidation      `json:"age_validation,omitempty"`
+	IssuingAuthority    *DocumentIssuingAuthority   `json:"issuing_authority,omitempty"`
+}
+
+// DocumentDataComparison asserts that the data on the document matches the applicant data
+type DocumentDataComparison struct {
+	Result    string `json:"result,omitempty"`
+	Breakdown struct {
+		IssuingCountry  *ReportBreakdownItem `json:"issuing_country,omitempty"`
+		Gender          *ReportBreakdownItem `json:"gender,omitempty"`
+		DateOfBirth     *ReportBreakdownItem `json:"date_of_birth,omitempty"`
+		FirstName       *ReportBreakdownItem `json:"first_name,omitempty"`
+		LastName        *ReportBreakdownItem `json:"last_name,omitempty"`
+		DocumentType    *ReportBreakdownItem `json:"document_type,omitempty"`
+		DocumentNumbers *ReportBreakdownItem `json:"document_numbers,omitempty"`
+		DateOfExpiry    *ReportBreakdownItem `json:"date_of_expiry,omitempty"`
+	} `json:"breakdown,omitempty"`
+}
+
+// DocumentDataValidation asserts that the data on the document is in the expected format
+type DocumentDataValidation struct {
+	Result    string `json:"result,omitempty"`
+	Breakdown struct {
+		Gender              *ReportBreakdownItem `json:"gender,omitempty"`
+		DateOfBirth         *ReportBreakdownItem `json:"date_of_birth,omitempty"`
+		DocumentNumbers     *ReportBreakdownItem `json:"document_numbers,omitempty"`
+		DocumentExpiration  *ReportBreakdownItem `json:"document_expiration,omitempty"`
+		ExpiryDate          *ReportBreakdownItem `json:"expiry_date,omitempty"`
+		MRZ                 *ReportBreakdownItem `json:"mrz,omitempty"`
+		Barcode             *ReportBreakdownItem `json:"barcode,omitempty"`
+		IssuingDate         *ReportBreakdownItem `json:"issuing_date,omitempty"`
+		IssuingCountryCheck *ReportBreakdownItem `json:"issuing_country_check,omitempty"`
+	} `json:"breakdown,omitempty"`
+}
+
+// DocumentDataConsistency asserts that the data is consistent across the different parts of the document
+type DocumentDataConsistency struct {
+	Result    string `json:"result,omitempty"`
+	Breakdown struct {
+		DateOfExpiry               *ReportBreakdownItem `json:"date_of_expiry,omitempty"`
+		DocumentNumbers            *ReportBreakdownItem `json:"document_numbers,omitempty"`
+		IssuingCountry             *ReportBreakdownItem `json:"issuing_country,omitempty"`
+		DocumentType               *ReportBreakdownItem `json:"document_type,omitempty"`
+		DateOfBirth                *ReportBreakdownItem `json:"date_of_birth,omitempty"`
+		Gender                     *ReportBreakdownItem `json:"gender,omitempty"`
+		FirstName                  *ReportBreakdownItem `json:"first_name,omitempty"`
+		LastName                   *ReportBreakdownItem `json:"last_name,omitempty"`
+		Nationality                *ReportBreakdownItem `json:"nationality,omitempty"`
+		MultipleDataSourcesPresent *ReportBreakdownItem `json:"multiple_data_sources_present,omitempty"`
+	} `json:"breakdown,omitempty"`
+}
+
+// DocumentImageIntegrity asserts that the document image was of sufficient quality to be processed
+type DocumentImageIntegrity struct {
+	Result    string `json:"result,omitempty"`
+	Breakdown struct {
+		ImageQuality              *ReportBreakdownItem `json:"image_quality,omitempty"`
+		SupportedDocument         *ReportBreakdownItem `json:"supported_document,omitempty"`
+		ColourPicture             *ReportBreakdownItem `json:"colour_picture,omitempty"`
+		ConclusiveDocumentQuality *ReportBreakdownItem `json:"conclusive_document_quality,omitempty"`
+	} `json:"breakdown,omitempty"`
+}
+
+// DocumentVisualAuthenticity asserts that the document is not fraudulent
+type DocumentVisualAuthenticity struct {
+	Result    string `json:"result,omitempty"`
+	Breakdown struct {
+		Fonts                   *ReportBreakdownItem `json:"fonts,omitempty"`
+		PictureFaceIntegrity    *ReportBreakdownItem `json:"picture_face_integrity,omitempty"`
+		Template                *ReportBreakdownItem `json:"template,omitempty"`
+		SecurityFeatures        *ReportBreakdownItem `json:"security_features,omitempty"`
+		OriginalDocumentPresent *ReportBreakdownItem `json:"original_document_present,omitempty"`
+		DigitalTampering        *ReportBreakdownItem `json:"digital_tampering,omitempty"`
+		FaceDetection           *ReportBreakdownItem `json:"face_detection,omitempty"`
+		Other                   *ReportBreakdownItem `json:"other,omitempty"`
+	} `json:"breakdown,omitempty"`
+}
+
+// DocumentAgeValidation asserts that the applicant is above the minimum accepted age
+type DocumentAgeValidation struct {
+	Result    string `json:"result,omitempty"`
+	Breakdown struct {
+		MinimumAcceptedAge *ReportBreakdownItem `json:"minimum_accepted_age,omitempty"`
+	} `json:"breakdown,omitempty"`
+}
+
+// DocumentIssuingAuthority asserts the authenticity of the document chip data (NFC)
+type DocumentIssuingAuthority struct {
+	Result    string `json:"result,omitempty"`
+	Breakdown struct {
+		NFCActiveAuthentication  *ReportBreakdownItem `json:"nfc_active_authentication,omitempty"`
+		NFCPassiveAuthentication *ReportBreakdownItem `json:"nfc_passive_authentication,omitempty"`
+	} `json:"breakdown,omitempty"`
+}
+
+// DocumentReportProperties holds the data extracted from the document
+type DocumentReportProperties struct {
+	DocumentType    DocumentType     `json:"document_type,omitempty"`
+	DocumentNumbers []DocumentNumber `json:"document_numbers,omitempty"`
+	IssuingCountry  string           `json:"issuing_country,omitempty"`
+	IssuingState    string           `json:"issuing_state,omitempty"`
+	IssuingDate     string           `json:"issuing_date,omitempty"`
+	DateOfExpiry    string           `json:"date_of_expiry,omitempty"`
+	FirstName       string           `json:"first_name,omitempty"`
+	MiddleName      string           `json:"middle_name,omitempty"`
+	LastName        string           `json:"last_name,omitempty"`
+	DateOfBirth     string           `json:"date_of_birth,omitempty"`
+	PlaceOfBirth    string           `json:"place_of_birth,omitempty"`
+	Gender          string           `json:"gender,omitempty"`
+	Nationality     string           `json:"nationality,omitempty"`
+	PersonalNumber  string           `json:"personal_number,omitempty"`
+	Address         string           `json:"address,omitempty"`
+	MRZLine1        string           `json:"mrz_line1,omitempty"`
+	MRZLine2        string           `json:"mrz_line2,omitempty"`
+	MRZLine3        string           `json:"mrz_line3,omitempty"`
+}
+
+// DocumentNumber is a number extracted from a document
+type DocumentNumber struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// AsDocumentReport returns the report with its breakdown and properties typed for document reports.
+// It fails if the report is not a document report.
+func (r *Report) AsDocumentReport() (*DocumentReport, error) {
+	if !strings.HasPrefix(string(r.Name), string(ReportNameDocument)) {
+		return nil, fmt.Errorf("report %s is a %s report, not a document report", r.ID, r.Name)
+	}
+
+	var report DocumentReport
+	if err := r.convert(&report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// convert decodes the report into a report type with a typed breakdown and properties, from the JSON
+// returned by the API when the report was decoded from it
+func (r *Report) convert(dest any) error {
+	rb := []byte(r.Raw)
+	if rb == nil {
+		var err error
+		if rb, err = json.Marshal(r); err != nil {
+			return fmt.Errorf("failed to marshal report: %w", err)
+		}
+	}
+
+	if err := json.Unmarshal(rb, dest); err != nil {
+		return fmt.Errorf("failed to decode report: %w", err)
+	}
+
+	return nil
+}
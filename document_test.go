@@ -1,9 +1,15 @@
 package onfido_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -39,6 +45,7 @@ func TestDocument(t *testing.T) {
 	t.Run("DownloadDocument", testDownloadDocument(run, testDocument.ID))
 	t.Run("DownloadDocumentNFCFace", testDownloadDocumentNFCFace(run, testDocument.ID))
 	t.Run("DownloadDocumentVideo", testDownloadDocumentVideo(run, testDocument.ID))
+	t.Run("BatchUploadDocuments", testBatchUploadDocuments(run, applicant.ID))
 }
 
 func testUploadDocument(run *testRun, applicantID string, file *os.File, setDocument *onfido.Document) func(*testing.T) {
@@ -162,7 +169,7 @@ func testDownloadDocument(run *testRun, documentId string) func(*testing.T) {
 		sleep(t, 5)
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				fileBytes, err := run.client.DownloadDocument(run.ctx, tt.input)
+				document, err := run.client.DownloadDocument(run.ctx, tt.input)
 				if tt.wantErr {
 					assert.Errorf(t, err, expectedError, tt.name, err)
 					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
@@ -170,12 +177,14 @@ func testDownloadDocument(run *testRun, documentId string) func(*testing.T) {
 				}
 
 				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
-				assert.NotNil(t, fileBytes, "expected document content to be downloaded")
-				assert.NotEmpty(t, fileBytes, "expected document content to not be empty")
+				if !assert.NotNil(t, document, "expected document content to be downloaded") {
+					return
+				}
+				assert.NotEmpty(t, document.Data, "expected document content to not be empty")
 
 				if os.Getenv("SAVE_FILES") == "true" {
 					now := time.Now().Unix()
-					saveFile(t, fileBytes, fmt.Sprintf("document-%s-%d.png", tt.input, now))
+					saveFile(t, document.Data, fmt.Sprintf("document-%s-%d.png", tt.input, now))
 				}
 			})
 		}
@@ -207,7 +216,7 @@ func testDownloadDocumentNFCFace(run *testRun, documentId string) func(*testing.
 		sleep(t, 5)
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				nfcFaceBytes, err := run.client.DownloadDocumentNFCFace(run.ctx, tt.input)
+				nfcFace, err := run.client.DownloadDocumentNFCFace(run.ctx, tt.input)
 				if tt.wantErr {
 					assert.Errorf(t, err, expectedError, tt.name, err)
 					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
@@ -215,8 +224,10 @@ func testDownloadDocumentNFCFace(run *testRun, documentId string) func(*testing.
 				}
 
 				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
-				assert.NotNil(t, nfcFaceBytes, "expected NFC face content to be downloaded")
-				assert.NotEmpty(t, nfcFaceBytes, "expected NFC face content to not be empty")
+				if !assert.NotNil(t, nfcFace, "expected NFC face content to be downloaded") {
+					return
+				}
+				assert.NotEmpty(t, nfcFace.Data, "expected NFC face content to not be empty")
 			})
 		}
 	}
@@ -247,7 +258,7 @@ func testDownloadDocumentVideo(run *testRun, documentId string) func(*testing.T)
 		sleep(t, 5)
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				videoBytes, err := run.client.DownloadDocumentVideo(run.ctx, tt.input)
+				video, err := run.client.DownloadDocumentVideo(run.ctx, tt.input)
 				if tt.wantErr {
 					assert.Errorf(t, err, expectedError, tt.name, err)
 					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
@@ -255,13 +266,280 @@ func testDownloadDocumentVideo(run *testRun, documentId string) func(*testing.T)
 				}
 
 				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
-				assert.NotNil(t, videoBytes, "expected video content to be downloaded")
-				assert.NotEmpty(t, videoBytes, "expected video content to not be empty")
+				if !assert.NotNil(t, video, "expected video content to be downloaded") {
+					return
+				}
+				assert.NotEmpty(t, video.Data, "expected video content to not be empty")
 			})
 		}
 	}
 }
 
+func testBatchUploadDocuments(run *testRun, applicantID string) func(*testing.T) {
+	return func(t *testing.T) {
+		sleep(t, 5)
+
+		var items []onfido.BatchUploadItem
+		for _, side := range []onfido.DocumentSide{onfido.DocumentSideFront, onfido.DocumentSideBack} {
+			file, err := os.Open("./test/medias/license.png")
+			if err != nil {
+				t.Fatalf("error reading file: %v", err)
+			}
+			defer file.Close()
+
+			items = append(items, onfido.UploadDocumentPayload{
+				File:     file,
+				Type:     onfido.DocumentTypeDrivingLicence,
+				FileType: "png",
+				Side:     side,
+			})
+		}
+
+		var progress []onfido.BatchUploadProgress
+		uploader := run.client.NewBatchUploader(
+			onfido.WithBatchConcurrency(2),
+			onfido.WithBatchProgress(func(p onfido.BatchUploadProgress) {
+				progress = append(progress, p)
+			}),
+		)
+
+		results, err := uploader.Upload(run.ctx, applicantID, items...)
+		assert.NoErrorf(t, err, expectedNoError, "BatchUpload", err)
+		assert.Len(t, results, len(items), "expected a result for every item")
+		assert.Len(t, progress, len(items), "expected progress to be reported for every item")
+
+		for i, result := range results {
+			assert.Equal(t, i, result.Index, "expected results to be in the order of the items")
+			if assert.NotNil(t, result.Document, "expected document to be uploaded") {
+				assert.Equal(t, applicantID, result.Document.ApplicantID, "expected applicant ID to be set on the item")
+			}
+		}
+	}
+}
+
+func TestUploadDocumentFromBytes(t *testing.T) {
+	data, err := os.ReadFile("./test/medias/license.png")
+	if err != nil {
+		t.Fatalf("error reading file: %v", err)
+	}
+
+	var form *multipart.Form
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseMultipartForm(10 << 20); err != nil {
+			return nil, err
+		}
+		form = req.MultipartForm
+
+		body := `{"id":"document-1","applicant_id":"applicant-1","file_name":"license.png"}`
+		return &http.Response{StatusCode: http.StatusCreated, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	t.Run("UploadsTheDataAsTheFile", func(t *testing.T) {
+		document, err := client.UploadDocumentFromBytes(context.Background(), onfido.UploadDocumentPayload{
+			ApplicantID: "applicant-1",
+			Type:        onfido.DocumentTypeDrivingLicence,
+			Side:        onfido.DocumentSideFront,
+		}, data, "license.png")
+		if !assert.NoErrorf(t, err, expectedNoError, "UploadDocumentFromBytes", err) {
+			return
+		}
+		assert.Equal(t, "document-1", document.ID)
+
+		if assert.NotNil(t, form, "expected a multipart form to be sent") {
+			assert.Equal(t, []string{"applicant-1"}, form.Value["applicant_id"])
+			assert.Equal(t, []string{"driving_licence"}, form.Value["type"])
+			if assert.Len(t, form.File["file"], 1, "expected a file part") {
+				header := form.File["file"][0]
+				assert.Equal(t, "license.png", header.Filename)
+				assert.Equal(t, "image/png", header.Header.Get("Content-Type"))
+
+				file, err := header.Open()
+				if assert.NoError(t, err) {
+					defer file.Close()
+					content, _ := io.ReadAll(file)
+					assert.Equal(t, data, content, "expected the data to be uploaded")
+				}
+			}
+		}
+	})
+
+	t.Run("RejectsEmptyData", func(t *testing.T) {
+		_, err := client.UploadDocumentFromBytes(context.Background(), onfido.UploadDocumentPayload{
+			ApplicantID: "applicant-1",
+			Type:        onfido.DocumentTypeDrivingLicence,
+		}, nil, "license.png")
+		assert.ErrorIsf(t, err, onfido.ErrInvalidFile, expectedError, "UploadDocumentFromBytes", err)
+	})
+}
+
+func TestUploadDocumentValidation(t *testing.T) {
+	png, err := os.ReadFile("./test/medias/license.png")
+	if err != nil {
+		t.Fatalf("error reading file: %v", err)
+	}
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		t.Errorf("expected no request to be sent, got %s %s", req.Method, req.URL.Path)
+		return nil, errors.New("unexpected request")
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	valid := onfido.UploadDocumentPayload{ApplicantID: "applicant-1", Type: onfido.DocumentTypePassport, Side: onfido.DocumentSideFront}
+	withPayload := func(fn func(*onfido.UploadDocumentPayload)) onfido.UploadDocumentPayload {
+		payload := valid
+		fn(&payload)
+		return payload
+	}
+
+	tests := []struct {
+		name      string
+		payload   onfido.UploadDocumentPayload
+		data      []byte
+		wantField string
+	}{
+		{
+			name:      "MissingApplicantID",
+			payload:   withPayload(func(p *onfido.UploadDocumentPayload) { p.ApplicantID = "" }),
+			data:      png,
+			wantField: "applicant_id",
+		},
+		{
+			name:      "MissingType",
+			payload:   withPayload(func(p *onfido.UploadDocumentPayload) { p.Type = "" }),
+			data:      png,
+			wantField: "type",
+		},
+		{
+			name:      "InvalidSide",
+			payload:   withPayload(func(p *onfido.UploadDocumentPayload) { p.Side = "left" }),
+			data:      png,
+			wantField: "side",
+		},
+		{
+			name:      "EmptyFile",
+			payload:   valid,
+			wantField: "file",
+		},
+		{
+			name:      "FileTooLarge",
+			payload:   valid,
+			data:      append(append([]byte{}, png...), make([]byte, onfido.MaxDocumentFileSize)...),
+			wantField: "file",
+		},
+		{
+			name:      "UnsupportedContentType",
+			payload:   valid,
+			data:      []byte("name,passport_number\nJohn,123456789\n"),
+			wantField: "file",
+		},
+		{
+			name:      "InvalidIssuingCountry",
+			payload:   withPayload(func(p *onfido.UploadDocumentPayload) { p.IssuingCountry = "GB" }),
+			data:      png,
+			wantField: "issuing_country",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.UploadDocumentFromBytes(context.Background(), tt.payload, tt.data, "document")
+
+			var validationErr *onfido.ValidationError
+			if assert.ErrorAsf(t, err, &validationErr, expectedError, tt.name, err) {
+				assert.Equal(t, tt.wantField, validationErr.Field)
+			}
+			assert.ErrorIsf(t, err, onfido.ErrValidation, expectedError, tt.name, err)
+		})
+	}
+
+	t.Run("MissingFile", func(t *testing.T) {
+		_, err := client.UploadDocument(context.Background(), valid)
+		assert.ErrorIsf(t, err, onfido.ErrInvalidFile, expectedError, "UploadDocument", err)
+	})
+}
+
+func TestDocumentCompatibilityCheck(t *testing.T) {
+	png, err := os.ReadFile("./test/medias/license.png")
+	if err != nil {
+		t.Fatalf("error reading file: %v", err)
+	}
+
+	var requests int
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		body := `{"id":"document-1"}`
+		return &http.Response{StatusCode: http.StatusCreated, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})
+
+	tests := []struct {
+		name      string
+		payload   onfido.UploadDocumentPayload
+		wantField string
+	}{
+		{
+			name:      "PassportBackSide",
+			payload:   onfido.UploadDocumentPayload{ApplicantID: "applicant-1", Type: onfido.DocumentTypePassport, Side: onfido.DocumentSideBack},
+			wantField: "side",
+		},
+		{
+			name:      "IdentityCardWithoutIssuingCountry",
+			payload:   onfido.UploadDocumentPayload{ApplicantID: "applicant-1", Type: onfido.DocumentTypeNationalIdentityCard, Side: onfido.DocumentSideFront},
+			wantField: "issuing_country",
+		},
+		{
+			name:    "CompatibleDocument",
+			payload: onfido.UploadDocumentPayload{ApplicantID: "applicant-1", Type: onfido.DocumentTypeNationalIdentityCard, Side: onfido.DocumentSideBack, IssuingCountry: "FRA"},
+		},
+	}
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport), onfido.WithDocumentCompatibilityCheck())
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = 0
+			_, err := client.UploadDocumentFromBytes(context.Background(), tt.payload, png, "document.png")
+			if tt.wantField == "" {
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.Equal(t, 1, requests, "expected the document to be uploaded")
+				return
+			}
+
+			var validationErr *onfido.ValidationError
+			if assert.ErrorAsf(t, err, &validationErr, expectedError, tt.name, err) {
+				assert.Equal(t, tt.wantField, validationErr.Field)
+			}
+			assert.Zero(t, requests, "expected no request to be sent")
+			assert.Equal(t, err, tt.payload.CheckCompatibility(), "expected the same error from CheckCompatibility")
+		})
+	}
+
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.UploadDocumentFromBytes(context.Background(), tests[0].payload, png, "document.png")
+		assert.NoErrorf(t, err, expectedNoError, "UploadDocumentFromBytes", err)
+	})
+}
+
 // save to test/medias/debug
 func saveFile(t *testing.T, content []byte, filename string) {
 	debugDir := filepath.Join("test", "medias", "debug")
@@ -0,0 +1,277 @@
+package onfido
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// ------------------------------------------------------------------
+//                              DOWNLOAD
+// ------------------------------------------------------------------
+
+// maxDownloadResumes is the number of times in a row a download is resumed without receiving any byte
+const maxDownloadResumes = 3
+
+// WithDownloadChunkSize downloads the videos of documents and live videos in ranged requests of size
+// bytes instead of a single request, so that a slow link doesn't hit the client timeout.
+//
+// Video downloads are resumed from the last byte received when the connection drops, whether
+// they are chunked or not. A size of 0, the default, downloads a video in a single request.
+func WithDownloadChunkSize(size int64) ClientOption {
+	return func(c *clientOptions) {
+		c.chunkSize = size
+	}
+}
+
+// Download is the content of a downloaded file with its metadata
+type Download struct {
+	Data []byte
+	// ContentType is the media type of the content as sent by the API, e.g. "image/png"
+	ContentType string
+	// Filename is the file name suggested by the API, empty when none is suggested
+	Filename string
+	// Size is the size of the content in bytes
+	Size int64
+}
+
+// DownloadStream is the unbuffered content of a download, read it to the end or close it early.
+//   - The caller must close the stream to release the underlying connection
+type DownloadStream struct {
+	io.ReadCloser
+	// ContentType is the media type of the content as sent by the API, e.g. "image/png"
+	ContentType string
+	// Filename is the file name suggested by the API, empty when none is suggested
+	Filename string
+	// ContentLength is the size of the content in bytes, or -1 when unknown
+	ContentLength int64
+}
+
+// copyTo writes the rest of the stream to w then closes it, returning the number of bytes written
+func (s *DownloadStream) copyTo(w io.Writer) (int64, error) {
+	defer s.Close()
+
+	n, err := io.Copy(w, s.ReadCloser)
+	if err != nil {
+		return n, fmt.Errorf("failed to write download: %w", err)
+	}
+
+	return n, nil
+}
+
+// filenameFromContentDisposition returns the file name of a Content-Disposition header, without any directory
+func filenameFromContentDisposition(contentDisposition string) string {
+	if contentDisposition == "" {
+		return ""
+	}
+
+	_, params, err := mime.ParseMediaType(contentDisposition)
+	if err != nil {
+		return ""
+	}
+
+	filename := path.Base(strings.ReplaceAll(params["filename"], "\\", "/"))
+	if filename == "." || filename == "/" || filename == ".." {
+		return ""
+	}
+	return filename
+}
+
+// resumableDownload streams the file at path into memory with range requests, see [Client.resumableDownloadStream]
+func (c *Client) resumableDownload(ctx context.Context, path string) (*Download, error) {
+	body, err := c.resumableDownloadStream(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return readDownload(body)
+}
+
+// resumableDownloadStream returns a stream of the file at path fetched with range requests, resumed
+// from the last byte received on connection errors, the caller must close it
+func (c *Client) resumableDownloadStream(ctx context.Context, path string) (*DownloadStream, error) {
+	r := &rangeReader{ctx: ctx, client: c, path: path, chunkSize: c.chunkSize, size: -1}
+	headers, err := r.open()
+	if err != nil {
+		return nil, err
+	}
+
+	return &DownloadStream{
+		ReadCloser:    r,
+		ContentType:   headers.Get("Content-Type"),
+		Filename:      filenameFromContentDisposition(headers.Get("Content-Disposition")),
+		ContentLength: r.size,
+	}, nil
+}
+
+// rangeReader reads a download chunk by chunk, reopening it from its offset when reading fails
+type rangeReader struct {
+	ctx       context.Context
+	client    *Client
+	path      string
+	chunkSize int64
+
+	body       io.ReadCloser
+	offset     int64 // bytes read so far
+	size       int64 // size of the file, -1 when unknown
+	partial    bool  // whether the body is a range of the file
+	chunkStart int64 // first byte of the body
+	chunkEnd   int64 // byte after the last byte of the body, -1 when unknown
+	failures   int   // resumes in a row without receiving any byte
+	finished   bool
+	closed     bool
+}
+
+func (r *rangeReader) Read(p []byte) (int, error) {
+	if r.closed {
+		return 0, errors.New("read on closed download")
+	}
+
+	for {
+		if r.finished {
+			return 0, io.EOF
+		}
+		if r.body == nil {
+			if _, err := r.open(); err != nil {
+				return 0, err
+			}
+		}
+
+		n, err := r.body.Read(p)
+		r.offset += int64(n)
+		if n > 0 {
+			r.failures = 0
+		}
+		if err == nil {
+			return n, nil
+		}
+
+		r.closeBody()
+		if err == io.EOF && r.complete() {
+			r.finished = true
+			return n, io.EOF
+		}
+
+		// unless the chunk is complete the connection dropped, either way the next read goes on from the offset
+		if err != io.EOF || r.chunkEnd < 0 || r.offset < r.chunkEnd {
+			r.failures++
+			if r.failures > maxDownloadResumes || r.ctx.Err() != nil {
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
+				return n, fmt.Errorf("failed to read download: %w", err)
+			}
+		}
+
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// complete reports, once a body is read to its end, whether it was the end of the file
+func (r *rangeReader) complete() bool {
+	switch {
+	case r.size >= 0:
+		return r.offset >= r.size
+	case !r.partial:
+		return true
+	default:
+		// without a known size, the file ends with the first chunk shorter than requested
+		return r.chunkSize == 0 || r.chunkEnd-r.chunkStart < r.chunkSize
+	}
+}
+
+func (r *rangeReader) Close() error {
+	r.closed = true
+	r.closeBody()
+	return nil
+}
+
+func (r *rangeReader) closeBody() {
+	if r.body != nil {
+		r.body.Close()
+		r.body = nil
+	}
+}
+
+// open requests the file from the offset, up to the chunk size, and returns the response headers
+func (r *rangeReader) open() (http.Header, error) {
+	headers := make(http.Header)
+	switch {
+	case r.chunkSize > 0:
+		headers.Set("Range", fmt.Sprintf("bytes=%d-%d", r.offset, r.offset+r.chunkSize-1))
+	case r.offset > 0:
+		headers.Set("Range", fmt.Sprintf("bytes=%d-", r.offset))
+	}
+
+	resp, err := r.client.openDownload(r.ctx, r.path, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusPartialContent {
+		start, end, size, err := parseContentRange(resp.Headers.Get("Content-Range"))
+		if err != nil || start != r.offset {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected content range %q for download from byte %d", resp.Headers.Get("Content-Range"), r.offset)
+		}
+		r.partial, r.chunkStart, r.chunkEnd, r.size = true, start, end+1, size
+	} else {
+		// the whole file is sent when ranges aren't supported, skip what was already read
+		if r.offset > 0 {
+			if _, err := io.CopyN(io.Discard, resp.Body, r.offset); err != nil {
+				resp.Body.Close()
+				return nil, fmt.Errorf("failed to resume download: %w", err)
+			}
+		}
+		r.partial, r.chunkStart, r.chunkEnd = false, 0, resp.ContentLength
+		if resp.ContentLength >= 0 {
+			r.size = resp.ContentLength
+		}
+	}
+
+	r.body = resp.Body
+	return resp.Headers, nil
+}
+
+// parseContentRange parses a Content-Range header such as "bytes 0-99/1234", the size is -1 when unknown
+func parseContentRange(contentRange string) (start, end, size int64, err error) {
+	invalid := fmt.Errorf("invalid content range %q", contentRange)
+
+	unit, rest, ok := strings.Cut(contentRange, " ")
+	if !ok || unit != "bytes" {
+		return 0, 0, 0, invalid
+	}
+
+	byteRange, total, ok := strings.Cut(rest, "/")
+	if !ok {
+		return 0, 0, 0, invalid
+	}
+
+	first, last, ok := strings.Cut(byteRange, "-")
+	if !ok {
+		return 0, 0, 0, invalid
+	}
+	if start, err = strconv.ParseInt(first, 10, 64); err != nil {
+		return 0, 0, 0, invalid
+	}
+	if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+		return 0, 0, 0, invalid
+	}
+
+	size = -1
+	if total != "*" {
+		if size, err = strconv.ParseInt(total, 10, 64); err != nil {
+			return 0, 0, 0, invalid
+		}
+	}
+
+	return start, end, size, nil
+}
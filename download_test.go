@@ -0,0 +1,380 @@
+package onfido_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownload(t *testing.T) {
+	const content = "\x89PNG\r\n\x1a\nimage"
+
+	var contentDisposition string
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		header := http.Header{"Content-Type": {"image/png"}}
+		if contentDisposition != "" {
+			header.Set("Content-Disposition", contentDisposition)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(content)), Request: req}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	tests := []struct {
+		name               string
+		contentDisposition string
+		wantFilename       string
+	}{
+		{name: "SuggestedFilename", contentDisposition: `attachment; filename="passport.png"`, wantFilename: "passport.png"},
+		{name: "EncodedFilename", contentDisposition: `attachment; filename*=UTF-8''pi%C3%A8ce.png`, wantFilename: "pièce.png"},
+		{name: "FilenameWithoutDirectory", contentDisposition: `attachment; filename="../../etc/passport.png"`, wantFilename: "passport.png"},
+		{name: "NoSuggestedFilename", wantFilename: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contentDisposition = tt.contentDisposition
+
+			download, err := client.DownloadDocument(context.Background(), "document-1")
+			if !assert.NoErrorf(t, err, expectedNoError, "DownloadDocument", err) {
+				return
+			}
+
+			assert.Equal(t, content, string(download.Data))
+			assert.Equal(t, "image/png", download.ContentType)
+			assert.Equal(t, int64(len(content)), download.Size)
+			assert.Equal(t, tt.wantFilename, download.Filename)
+		})
+	}
+}
+
+func TestDownloadStream(t *testing.T) {
+	const content = "\x89PNG\r\n\x1a\nimage"
+
+	var paths []string
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		if strings.Contains(req.URL.Path, "missing") {
+			body := `{"error":{"type":"resource_not_found","message":"The requested resource was not found"}}`
+			return &http.Response{StatusCode: http.StatusNotFound, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+		}
+		return &http.Response{
+			StatusCode:    http.StatusOK,
+			Header:        http.Header{"Content-Type": {"image/png"}},
+			Body:          io.NopCloser(strings.NewReader(content)),
+			ContentLength: int64(len(content)),
+			Request:       req,
+		}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	ctx := context.Background()
+
+	t.Run("StreamContentWithMetadata", func(t *testing.T) {
+		stream, err := client.DownloadDocumentStream(ctx, "document-1")
+		if !assert.NoErrorf(t, err, expectedNoError, "DownloadDocumentStream", err) {
+			return
+		}
+		defer stream.Close()
+
+		assert.Equal(t, "image/png", stream.ContentType)
+		assert.Equal(t, int64(len(content)), stream.ContentLength)
+
+		data, err := io.ReadAll(stream)
+		assert.NoError(t, err)
+		assert.Equal(t, content, string(data))
+	})
+
+	t.Run("DownloadEveryMedia", func(t *testing.T) {
+		paths = nil
+		downloads := []func(context.Context, string) (*onfido.DownloadStream, error){
+			client.DownloadDocumentVideoStream,
+			client.DownloadLivePhotoStream,
+			client.DownloadLiveVideoStream,
+			client.DownloadMotionCaptureStream,
+			client.DownloadIDPhotoStream,
+		}
+		for _, download := range downloads {
+			stream, err := download(ctx, "media-1")
+			if assert.NoError(t, err) {
+				stream.Close()
+			}
+		}
+		assert.Equal(t, []string{
+			"/v3.6/documents/media-1/video/download",
+			"/v3.6/live_photos/media-1/download",
+			"/v3.6/live_videos/media-1/download",
+			"/v3.6/motion_captures/media-1/download",
+			"/v3.6/id_photos/media-1/download",
+		}, paths)
+	})
+
+	t.Run("ReturnFailedDownloadErrors", func(t *testing.T) {
+		_, err := client.DownloadDocumentStream(ctx, "missing")
+		assert.ErrorIsf(t, err, onfido.ErrNotFound, expectedError, "DownloadDocumentStream", err)
+
+		_, err = client.DownloadDocumentStream(ctx, "")
+		assert.ErrorIsf(t, err, onfido.ErrInvalidId, expectedError, "DownloadDocumentStream", err)
+	})
+}
+
+func TestDownloadRedirect(t *testing.T) {
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		header := http.Header{"Location": {"https://storage.example.com/media-1"}}
+		return &http.Response{StatusCode: http.StatusFound, Header: header, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	ctx := context.Background()
+
+	downloads := map[string]func() error{
+		"DownloadDocument": func() error {
+			_, err := client.DownloadDocument(ctx, "media-1")
+			return err
+		},
+		"DownloadDocumentStream": func() error {
+			_, err := client.DownloadDocumentStream(ctx, "media-1")
+			return err
+		},
+		"DownloadLivePhoto": func() error {
+			_, err := client.DownloadLivePhoto(ctx, "media-1")
+			return err
+		},
+		"DownloadMotionCaptureTo": func() error {
+			_, err := client.DownloadMotionCaptureTo(ctx, "media-1", io.Discard)
+			return err
+		},
+	}
+	for name, download := range downloads {
+		t.Run(name, func(t *testing.T) {
+			err := download()
+
+			var onfidoErr *onfido.OnfidoError
+			if assert.ErrorAsf(t, err, &onfidoErr, expectedError, name, err) {
+				assert.Equal(t, http.StatusFound, onfidoErr.StatusCode)
+			}
+		})
+	}
+}
+
+func TestDownloadTo(t *testing.T) {
+	const content = "\x00\x00\x00\x18ftypmp42video"
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"video/mp4"}}, Body: io.NopCloser(strings.NewReader(content)), Request: req}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	downloads := map[string]func(context.Context, string, io.Writer) (int64, error){
+		"DownloadDocumentTo":      client.DownloadDocumentTo,
+		"DownloadDocumentVideoTo": client.DownloadDocumentVideoTo,
+		"DownloadLivePhotoTo":     client.DownloadLivePhotoTo,
+		"DownloadLiveVideoTo":     client.DownloadLiveVideoTo,
+		"DownloadMotionCaptureTo": client.DownloadMotionCaptureTo,
+		"DownloadIDPhotoTo":       client.DownloadIDPhotoTo,
+	}
+	for name, download := range downloads {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			n, err := download(context.Background(), "media-1", &buf)
+			assert.NoErrorf(t, err, expectedNoError, name, err)
+			assert.Equal(t, int64(len(content)), n, "expected the number of bytes written")
+			assert.Equal(t, content, buf.String())
+		})
+	}
+
+	t.Run("ReturnWriteErrors", func(t *testing.T) {
+		_, err := client.DownloadDocumentTo(context.Background(), "media-1", failingWriter{})
+		assert.Errorf(t, err, expectedError, "DownloadDocumentTo", err)
+	})
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+func TestResumableDownload(t *testing.T) {
+	const content = "0123456789abcdefghij"
+
+	// rangeServer serves content with range requests, the first drops responses break after dropAfter bytes
+	rangeServer := func(supportRanges bool, drops, dropAfter int) (http.RoundTripper, *[]string) {
+		var ranges []string
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			rangeHeader := req.Header.Get("Range")
+			ranges = append(ranges, rangeHeader)
+
+			status, header, body := http.StatusOK, http.Header{"Content-Type": {"video/mp4"}}, content
+			if supportRanges && rangeHeader != "" {
+				var start, end int
+				if _, err := fmt.Sscanf(rangeHeader, "bytes=%d-%d", &start, &end); err != nil || end >= len(content) {
+					end = len(content) - 1
+				}
+				status, body = http.StatusPartialContent, content[start:end+1]
+				header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(content)))
+			}
+			header.Set("Content-Length", strconv.Itoa(len(body)))
+
+			var reader io.Reader = strings.NewReader(body)
+			if drops > 0 {
+				drops--
+				reader = io.MultiReader(strings.NewReader(body[:dropAfter]), errReader{errors.New("connection reset by peer")})
+			}
+			return &http.Response{StatusCode: status, Header: header, Body: io.NopCloser(reader), ContentLength: int64(len(body)), Request: req}, nil
+		}), &ranges
+	}
+
+	tests := []struct {
+		name          string
+		supportRanges bool
+		drops         int
+		dropAfter     int
+		chunkSize     int64
+		wantRanges    []string
+		wantErr       bool
+	}{
+		{
+			name:          "DownloadInChunks",
+			supportRanges: true,
+			chunkSize:     8,
+			wantRanges:    []string{"bytes=0-7", "bytes=8-15", "bytes=16-23"},
+		},
+		{
+			name:          "ResumeFromLastByte",
+			supportRanges: true,
+			drops:         1,
+			dropAfter:     3,
+			wantRanges:    []string{"", "bytes=3-"},
+		},
+		{
+			name:          "ResumeChunkFromLastByte",
+			supportRanges: true,
+			drops:         1,
+			dropAfter:     3,
+			chunkSize:     8,
+			wantRanges:    []string{"bytes=0-7", "bytes=3-10", "bytes=11-18", "bytes=19-26"},
+		},
+		{
+			name:       "ResumeWithoutRangeSupport",
+			drops:      1,
+			dropAfter:  3,
+			wantRanges: []string{"", "bytes=3-"},
+		},
+		{
+			name:          "GiveUpWithoutProgress",
+			supportRanges: true,
+			drops:         10,
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport, ranges := rangeServer(tt.supportRanges, tt.drops, tt.dropAfter)
+			client, teardown, err := setupClient("token", onfido.WithTransport(transport), onfido.WithDownloadChunkSize(tt.chunkSize))
+			if err != nil {
+				t.Fatalf("error setting up client: %v", err)
+			}
+			defer teardown()
+
+			video, err := client.DownloadLiveVideoStream(context.Background(), "live-video-1")
+			if !assert.NoErrorf(t, err, expectedNoError, "DownloadLiveVideoStream", err) {
+				return
+			}
+			defer video.Close()
+
+			data, err := io.ReadAll(video)
+			if tt.wantErr {
+				assert.Errorf(t, err, expectedError, "DownloadLiveVideoStream", err)
+				return
+			}
+
+			assert.NoErrorf(t, err, expectedNoError, "DownloadLiveVideoStream", err)
+			assert.Equal(t, content, string(data), "expected the whole video to be downloaded")
+			assert.Equal(t, tt.wantRanges, *ranges, "expected range requests")
+		})
+	}
+
+	t.Run("ReturnRedirectErrors", func(t *testing.T) {
+		transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			header := http.Header{"Location": {"https://storage.example.com/live-video-1"}}
+			return &http.Response{StatusCode: http.StatusFound, Header: header, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+		})
+		client, teardown, err := setupClient("token", onfido.WithTransport(transport), onfido.WithDownloadChunkSize(8))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		var onfidoErr *onfido.OnfidoError
+
+		_, err = client.DownloadLiveVideoStream(context.Background(), "live-video-1")
+		if assert.ErrorAsf(t, err, &onfidoErr, expectedError, "DownloadLiveVideoStream", err) {
+			assert.Equal(t, http.StatusFound, onfidoErr.StatusCode)
+		}
+
+		_, err = client.DownloadDocumentVideo(context.Background(), "document-1")
+		if assert.ErrorAsf(t, err, &onfidoErr, expectedError, "DownloadDocumentVideo", err) {
+			assert.Equal(t, http.StatusFound, onfidoErr.StatusCode)
+		}
+	})
+
+	t.Run("ReportTheSizeOfChunkedDownloads", func(t *testing.T) {
+		transport, _ := rangeServer(true, 0, 0)
+		client, teardown, err := setupClient("token", onfido.WithTransport(transport), onfido.WithDownloadChunkSize(8))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		download, err := client.DownloadDocumentVideo(context.Background(), "document-1")
+		if assert.NoErrorf(t, err, expectedNoError, "DownloadDocumentVideo", err) {
+			assert.Equal(t, content, string(download.Data))
+			assert.Equal(t, int64(len(content)), download.Size)
+			assert.Equal(t, "video/mp4", download.ContentType)
+		}
+
+		stream, err := client.DownloadDocumentVideoStream(context.Background(), "document-1")
+		if assert.NoErrorf(t, err, expectedNoError, "DownloadDocumentVideoStream", err) {
+			defer stream.Close()
+			assert.Equal(t, int64(len(content)), stream.ContentLength, "expected the size of the whole video")
+		}
+	})
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
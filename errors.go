@@ -1,17 +1,69 @@
 package onfido
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
 
-var ErrInvalidId = &OnfidoError{Type: "validation_error", Message: "id is required"}
+	"github.com/besafe-labs/onfido-go-sdk/internal/httpclient"
+)
+
+var ErrInvalidId = &ValidationError{Field: "id", Message: "id is required"}
+
+// ErrInvalidPostcode is returned when searching addresses without a postcode
+var ErrInvalidPostcode = &ValidationError{Field: "postcode", Message: "postcode is required"}
+
+// ErrInvalidSdkTokenTarget is returned when an SDK token payload does not set exactly one of referrer or application_id
+var ErrInvalidSdkTokenTarget = &ValidationError{Field: "referrer", Message: "exactly one of referrer or application_id is required"}
+
+// ErrInvalidPayload is returned when a method is called without its payload
+var ErrInvalidPayload = &ValidationError{Field: "payload", Message: "payload is required"}
+
+// ErrInvalidFile is returned when uploading a file without content
+var ErrInvalidFile = &ValidationError{Field: "file", Message: "file is required"}
+
+// ErrRegionMismatch is returned when the region guard is enabled and a request would be sent
+// to a region other than the one the API token belongs to
+var ErrRegionMismatch = errors.New("onfido: region mismatch")
+
+// ErrPageOutOfRange is returned when listing a page past the last page of a non-empty list
+var ErrPageOutOfRange = errors.New("onfido: page out of range")
+
+// ErrNotFound matches the errors of requests for resources that don't exist
+var ErrNotFound = errors.New("onfido: not found")
+
+// ErrRateLimited matches the errors of requests refused by the rate limiter of the API
+var ErrRateLimited = errors.New("onfido: rate limited")
+
+// ErrUnauthorized matches the errors of requests refused because of the API token or its permissions
+var ErrUnauthorized = errors.New("onfido: unauthorized")
+
+// ErrValidation matches the errors of requests with invalid parameters or payloads
+var ErrValidation = errors.New("onfido: validation failed")
+
+// ErrServerError matches the errors of requests that failed on the side of the API
+var ErrServerError = errors.New("onfido: server error")
+
+// ErrResponseTooLarge is returned when a response exceeds the size set with [WithMaxResponseSize]
+var ErrResponseTooLarge = httpclient.ErrResponseTooLarge
 
 // ------------------------------------------------------------------
 //                          ONFIDO ERROR
 // ------------------------------------------------------------------
 
+// RequestIDHeader is the response header holding the identifier given by Onfido to a request
+const RequestIDHeader = httpclient.RequestIDHeader
+
 type OnfidoError struct {
 	Type    string         `json:"type,omitempty"`
 	Message string         `json:"message,omitempty"`
 	Fields  map[string]any `json:"fields,omitempty"`
+	// RequestID is the identifier given by Onfido to the failed request, to quote in support tickets
+	RequestID string `json:"-"`
+	// StatusCode is the HTTP status of the failed request, 0 for errors raised by the SDK
+	StatusCode int `json:"-"`
+	// RawBody is the body of the failed response, as returned by the API
+	RawBody []byte `json:"-"`
 }
 
 func (e OnfidoError) Error() string {
@@ -25,6 +77,14 @@ func (e OnfidoError) Error() string {
 		msg += fmt.Sprintf("\tMessage: %s\n", e.Message)
 	}
 
+	if e.StatusCode != 0 {
+		msg += fmt.Sprintf("\tStatusCode: %d\n", e.StatusCode)
+	}
+
+	if e.RequestID != "" {
+		msg += fmt.Sprintf("\tRequestID: %s\n", e.RequestID)
+	}
+
 	if len(e.Fields) > 0 {
 		msg += "\tFields:\t"
 		for k, v := range e.Fields {
@@ -33,3 +93,92 @@ func (e OnfidoError) Error() string {
 	}
 	return msg
 }
+
+// Is reports whether the error belongs to the category of target, one of [ErrNotFound], [ErrRateLimited],
+// [ErrUnauthorized], [ErrValidation] or [ErrServerError], based on its type and HTTP status:
+//
+//	if errors.Is(err, onfido.ErrNotFound) {
+//		// ...
+//	}
+func (e OnfidoError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || e.Type == "resource_not_found"
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests || e.Type == "rate_limit"
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden ||
+			e.Type == "authorization_error" || e.Type == "user_authorization_error"
+	case ErrValidation:
+		return e.StatusCode == http.StatusUnprocessableEntity || e.Type == "validation_error"
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// ------------------------------------------------------------------
+//                         VALIDATION ERROR
+// ------------------------------------------------------------------
+
+// ValidationError describes invalid arguments detected by the SDK before sending any request,
+// unlike an [OnfidoError] which is returned by the API. Retrying the call can't fix it.
+//
+// It matches [ErrValidation] with errors.Is, like the validation errors of the API.
+type ValidationError struct {
+	// Field is the argument or payload field that is invalid
+	Field string
+	// Message describes why the field is invalid
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("onfido: validation_error: %s", e.Message)
+}
+
+func (e ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// ------------------------------------------------------------------
+//                        REGION MISMATCH ERROR
+// ------------------------------------------------------------------
+
+// RegionMismatchError describes a request refused by the region guard
+type RegionMismatchError struct {
+	// Region is the region the request would have been sent to
+	Region apiRegion
+	// TokenRegion is the region inferred from the API token, empty if it could not be inferred
+	TokenRegion apiRegion
+}
+
+func (e RegionMismatchError) Error() string {
+	if e.TokenRegion == "" {
+		return fmt.Sprintf("%s: unable to infer the region of the api token, refusing to call region %q", ErrRegionMismatch, e.Region)
+	}
+	return fmt.Sprintf("%s: api token belongs to region %q, refusing to call region %q", ErrRegionMismatch, e.TokenRegion, e.Region)
+}
+
+func (e RegionMismatchError) Is(target error) bool {
+	return target == ErrRegionMismatch
+}
+
+// ------------------------------------------------------------------
+//                       PAGE OUT OF RANGE ERROR
+// ------------------------------------------------------------------
+
+// PageOutOfRangeError describes a list request for a page past the last page
+type PageOutOfRangeError struct {
+	// Page is the requested page
+	Page int
+	// LastPage is the last page holding data
+	LastPage int
+}
+
+func (e PageOutOfRangeError) Error() string {
+	return fmt.Sprintf("%s: requested page %d, last page is %d", ErrPageOutOfRange, e.Page, e.LastPage)
+}
+
+func (e PageOutOfRangeError) Is(target error) bool {
+	return target == ErrPageOutOfRange
+}
@@ -0,0 +1,166 @@
+package onfido_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubTransport answers every request with the given status and body
+func stubTransport(status int, body string, header http.Header) roundTripperFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		h := http.Header{"Content-Type": {"application/json"}}
+		for k, v := range header {
+			h[k] = v
+		}
+		return &http.Response{
+			StatusCode: status,
+			Status:     http.StatusText(status),
+			Header:     h,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	}
+}
+
+func TestOnfidoError(t *testing.T) {
+	t.Run("RequestID", testErrorRequestID)
+	t.Run("Sentinels", testErrorSentinels)
+	t.Run("HTTPContext", testErrorHTTPContext)
+}
+
+func testErrorHTTPContext(t *testing.T) {
+	body := `<html><body>Bad Gateway</body></html>`
+	client, teardown, err := setupClient("token", onfido.WithTransport(stubTransport(http.StatusBadGateway, body, nil)))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+	var onfidoErr *onfido.OnfidoError
+	if assert.ErrorAs(t, err, &onfidoErr, "expected error to be an OnfidoError") {
+		assert.Equal(t, http.StatusBadGateway, onfidoErr.StatusCode)
+		assert.Equal(t, body, string(onfidoErr.RawBody), "expected raw body to be kept")
+	}
+}
+
+func testErrorSentinels(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error
+	}{
+		{name: "MatchNotFound", status: http.StatusNotFound, body: `{"error":{"type":"resource_not_found"}}`, want: onfido.ErrNotFound},
+		{name: "MatchRateLimited", status: http.StatusTooManyRequests, body: `{"error":{"type":"rate_limit"}}`, want: onfido.ErrRateLimited},
+		{name: "MatchUnauthorized", status: http.StatusUnauthorized, body: `{"error":{"type":"authorization_error"}}`, want: onfido.ErrUnauthorized},
+		{name: "MatchValidation", status: http.StatusUnprocessableEntity, body: `{"error":{"type":"validation_error"}}`, want: onfido.ErrValidation},
+		{name: "MatchServerErrorWithoutBody", status: http.StatusServiceUnavailable, body: `<html></html>`, want: onfido.ErrServerError},
+	}
+	sentinels := []error{onfido.ErrNotFound, onfido.ErrRateLimited, onfido.ErrUnauthorized, onfido.ErrValidation, onfido.ErrServerError}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, teardown, err := setupClient("token", onfido.WithTransport(stubTransport(tt.status, tt.body, nil)))
+			if err != nil {
+				t.Fatalf("error setting up client: %v", err)
+			}
+			defer teardown()
+
+			_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+			for _, sentinel := range sentinels {
+				assert.Equalf(t, sentinel == tt.want, errors.Is(err, sentinel), "errors.Is(%v, %v)", err, sentinel)
+			}
+		})
+	}
+
+	t.Run("MatchLocalValidationErrors", func(t *testing.T) {
+		assert.ErrorIs(t, onfido.ErrInvalidId, onfido.ErrValidation)
+	})
+}
+
+func TestValidationError(t *testing.T) {
+	client, teardown, err := setupClient("token", onfido.WithTransport(stubTransport(http.StatusUnprocessableEntity,
+		`{"error":{"type":"validation_error","message":"There was a validation error on this request"}}`, nil)))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	t.Run("ReturnValidationErrorBeforeSendingRequest", func(t *testing.T) {
+		_, err := client.RetrieveApplicant(context.Background(), "")
+		var validationErr *onfido.ValidationError
+		if assert.ErrorAs(t, err, &validationErr, "expected error to be a ValidationError") {
+			assert.Equal(t, "id", validationErr.Field)
+		}
+		var onfidoErr *onfido.OnfidoError
+		assert.False(t, errors.As(err, &onfidoErr), "expected local error not to be an OnfidoError")
+		assert.Containsf(t, err.Error(), "validation_error", errorContains, "validation_error", err.Error())
+	})
+
+	t.Run("ReturnOnfidoErrorFromAPI", func(t *testing.T) {
+		_, err := client.RetrieveApplicant(context.Background(), "applicant-id")
+		var validationErr *onfido.ValidationError
+		assert.False(t, errors.As(err, &validationErr), "expected API error not to be a ValidationError")
+		assert.ErrorIs(t, err, onfido.ErrValidation)
+	})
+}
+
+func testErrorRequestID(t *testing.T) {
+	requestID := http.Header{onfido.RequestIDHeader: {"req-123"}}
+
+	t.Run("CaptureRequestIDOnErrors", func(t *testing.T) {
+		client, teardown, err := setupClient("token", onfido.WithTransport(stubTransport(http.StatusNotFound,
+			`{"error":{"type":"resource_not_found","message":"The requested resource was not found"}}`, requestID)))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+		var onfidoErr *onfido.OnfidoError
+		if assert.ErrorAs(t, err, &onfidoErr, "expected error to be an OnfidoError") {
+			assert.Equal(t, "req-123", onfidoErr.RequestID)
+			assert.Contains(t, err.Error(), "req-123", "expected request id in error message")
+		}
+	})
+
+	t.Run("CaptureRequestIDOnErrorsWithoutBody", func(t *testing.T) {
+		client, teardown, err := setupClient("token", onfido.WithTransport(stubTransport(http.StatusBadGateway, `{}`, requestID)))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+		var onfidoErr *onfido.OnfidoError
+		if assert.ErrorAs(t, err, &onfidoErr, "expected error to be an OnfidoError") {
+			assert.Equal(t, "req-123", onfidoErr.RequestID)
+		}
+	})
+
+	t.Run("ReportRequestIDOnSuccess", func(t *testing.T) {
+		var requestIDs []string
+		client, teardown, err := setupClient("token",
+			onfido.WithTransport(stubTransport(http.StatusOK, `{"id":"applicant-id"}`, requestID)),
+			onfido.WithRequestHook(func(ctx context.Context, info onfido.RequestInfo) {
+				requestIDs = append(requestIDs, info.RequestID)
+			}),
+		)
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+		assert.Equal(t, []string{"req-123"}, requestIDs, "expected request id to be reported to hooks")
+	})
+}
@@ -0,0 +1,124 @@
+package onfido
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+)
+
+// ------------------------------------------------------------------
+//                          EVIDENCE BUNDLE
+// ------------------------------------------------------------------
+
+// DownloadWorkflowRunEvidence writes a zip archive of the evidence of a workflow run to w.
+//
+// The archive holds the signed evidence file of the run along with the documents, live photos
+// and motion captures of its applicant:
+//
+//	signed_evidence_file.pdf
+//	documents/{id}-{file_name}
+//	live_photos/{id}-{file_name}
+//	motion_captures/{id}-{file_name}
+//
+// Media are streamed one at a time into the archive. The archive is incomplete if an error is returned.
+func (c *Client) DownloadWorkflowRunEvidence(ctx context.Context, workflowRunID string, w io.Writer) error {
+	if workflowRunID == "" {
+		return ErrInvalidId
+	}
+
+	workflowRun, err := c.RetrieveWorkflowRun(ctx, workflowRunID)
+	if err != nil {
+		return err
+	}
+
+	evidenceSummary, err := c.RetrieveWorkflowRunEvidenceSummaryFile(ctx, workflowRunID)
+	if err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+
+	if err := c.archiveSignedEvidence(ctx, archive, evidenceSummary.URL); err != nil {
+		return err
+	}
+
+	documents, _, err := c.ListDocuments(ctx, workflowRun.ApplicantID)
+	if err != nil {
+		return err
+	}
+	for _, document := range documents {
+		if err := c.archiveMedia(ctx, archive, "documents", document.ID, document.FileName, "/documents/"+document.ID+"/download"); err != nil {
+			return err
+		}
+	}
+
+	livePhotos := NewScanner(func(ctx context.Context, page int) ([]LivePhoto, *PageDetails, error) {
+		return c.ListLivePhotos(ctx, workflowRun.ApplicantID, WithPage(page))
+	})
+	for livePhotos.Scan(ctx) {
+		livePhoto := livePhotos.Item()
+		if err := c.archiveMedia(ctx, archive, "live_photos", livePhoto.ID, livePhoto.FileName, "/live_photos/"+livePhoto.ID+"/download"); err != nil {
+			return err
+		}
+	}
+	if err := livePhotos.Err(); err != nil {
+		return err
+	}
+
+	motionCaptures := NewScanner(func(ctx context.Context, page int) ([]MotionCapture, *PageDetails, error) {
+		return c.ListMotionCaptures(ctx, workflowRun.ApplicantID, WithPage(page))
+	})
+	for motionCaptures.Scan(ctx) {
+		motionCapture := motionCaptures.Item()
+		if err := c.archiveMedia(ctx, archive, "motion_captures", motionCapture.ID, motionCapture.FileName, "/motion_captures/"+motionCapture.ID+"/download"); err != nil {
+			return err
+		}
+	}
+	if err := motionCaptures.Err(); err != nil {
+		return err
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to close evidence archive: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) archiveSignedEvidence(ctx context.Context, archive *zip.Writer, url string) error {
+	resp, err := c.client.GetURLStream(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to download signed evidence file: %s", resp.Status)
+	}
+
+	return writeArchiveEntry(archive, "signed_evidence_file.pdf", resp.Body)
+}
+
+func (c *Client) archiveMedia(ctx context.Context, archive *zip.Writer, dir, id, fileName, downloadPath string) error {
+	body, err := c.downloadStream(ctx, downloadPath)
+	if err != nil {
+		return fmt.Errorf("failed to download %s %s: %w", dir, id, err)
+	}
+	defer body.Close()
+
+	return writeArchiveEntry(archive, mediaName(dir, id, fileName), body)
+}
+
+func writeArchiveEntry(archive *zip.Writer, name string, content io.Reader) error {
+	entry, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to create archive entry %s: %w", name, err)
+	}
+
+	if _, err := io.Copy(entry, content); err != nil {
+		return fmt.Errorf("failed to write archive entry %s: %w", name, err)
+	}
+
+	return nil
+}
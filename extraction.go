@@ -0,0 +1,95 @@
+package onfido
+
+import (
+	"context"
+)
+
+// ------------------------------------------------------------------
+//                              EXTRACTION
+// ------------------------------------------------------------------
+
+// Extraction represents the data extracted from a document by the Onfido autofill API
+type Extraction struct {
+	DocumentID             string                 `json:"document_id,omitempty"`
+	DocumentClassification DocumentClassification `json:"document_classification,omitempty"`
+	ExtractedData          ExtractedData          `json:"extracted_data,omitempty"`
+}
+
+// DocumentClassification describes the document identified by an extraction
+type DocumentClassification struct {
+	DocumentType   DocumentType `json:"document_type,omitempty"`
+	IssuingCountry string       `json:"issuing_country,omitempty"`
+	IssuingState   string       `json:"issuing_state,omitempty"`
+	Subtype        string       `json:"subtype,omitempty"`
+	Version        string       `json:"version,omitempty"`
+}
+
+// ExtractedData holds the data read from a document by an extraction.
+// Dates are formatted as YYYY-MM-DD, fields not present on the document are left empty.
+type ExtractedData struct {
+	DocumentNumber string `json:"document_number,omitempty"`
+	FirstName      string `json:"first_name,omitempty"`
+	MiddleName     string `json:"middle_name,omitempty"`
+	LastName       string `json:"last_name,omitempty"`
+	FullName       string `json:"full_name,omitempty"`
+	Gender         string `json:"gender,omitempty"`
+	DateOfBirth    string `json:"date_of_birth,omitempty"`
+	PlaceOfBirth   string `json:"place_of_birth,omitempty"`
+	Nationality    string `json:"nationality,omitempty"`
+	PersonalNumber string `json:"personal_number,omitempty"`
+	IssuingDate    string `json:"issuing_date,omitempty"`
+	DateOfExpiry   string `json:"date_of_expiry,omitempty"`
+	AddressLine1   string `json:"address_line_1,omitempty"`
+	AddressLine2   string `json:"address_line_2,omitempty"`
+	AddressLine3   string `json:"address_line_3,omitempty"`
+	AddressLine4   string `json:"address_line_4,omitempty"`
+	AddressLine5   string `json:"address_line_5,omitempty"`
+	MRZLine1       string `json:"mrz_line1,omitempty"`
+	MRZLine2       string `json:"mrz_line2,omitempty"`
+	MRZLine3       string `json:"mrz_line3,omitempty"`
+}
+
+// MRZLines returns the non-empty machine readable zone lines of the document
+func (d ExtractedData) MRZLines() []string {
+	var lines []string
+	for _, line := range []string{d.MRZLine1, d.MRZLine2, d.MRZLine3} {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// ExtractDocument extracts the data of an uploaded document with the Onfido autofill API,
+// without running a check
+func (c *Client) ExtractDocument(ctx context.Context, documentId string) (*Extraction, error) {
+	if documentId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var extraction Extraction
+
+	req := func() error {
+		body, err := c.buildJSON(map[string]string{"document_id": documentId})
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.Post(ctx, "/extractions", body, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &extraction)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &extraction, nil
+}
@@ -0,0 +1,105 @@
+package onfido_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtraction(t *testing.T) {
+	run := setupTestRun(t)
+	defer run.teardown()
+
+	applicant, err := run.client.CreateApplicant(run.ctx, onfido.CreateApplicantPayload{
+		FirstName: "John",
+		LastName:  "ExtractionTest",
+	})
+	if err != nil {
+		t.Fatalf("error creating applicant: %v", err)
+	}
+
+	file, err := os.Open("./test/medias/license.png")
+	if err != nil {
+		t.Fatalf("error reading file: %v", err)
+	}
+	defer file.Close()
+
+	document, err := run.client.UploadDocument(run.ctx, onfido.UploadDocumentPayload{
+		ApplicantID: applicant.ID,
+		File:        file,
+		Type:        onfido.DocumentTypeDrivingLicence,
+		FileType:    "png",
+		Side:        onfido.DocumentSideFront,
+	})
+	if err != nil {
+		t.Fatalf("error uploading document: %v", err)
+	}
+
+	t.Run("ExtractDocument", testExtractDocument(run, document.ID))
+}
+
+func testExtractDocument(run *testRun, documentId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "ExtractWithoutErrors",
+			input: documentId,
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				extraction, err := run.client.ExtractDocument(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.Equal(t, tt.input, extraction.DocumentID, "expected document ID to match")
+				assert.Equal(t, onfido.DocumentTypeDrivingLicence, extraction.DocumentClassification.DocumentType, "expected document to be classified")
+			})
+		}
+	}
+}
+
+func TestExtractionDecoding(t *testing.T) {
+	body := []byte(`{
+		"document_id": "document-id",
+		"document_classification": {
+			"document_type": "passport",
+			"issuing_country": "GBR"
+		},
+		"extracted_data": {
+			"document_number": "123456789",
+			"first_name": "Jane",
+			"last_name": "Doe",
+			"date_of_birth": "1990-01-01",
+			"date_of_expiry": "2030-01-01",
+			"mrz_line1": "P<GBRDOE<<JANE<<<<<<<<<<<<<<<<<<<<<<<<<<<<<",
+			"mrz_line2": "1234567897GBR9001014F3001019<<<<<<<<<<<<<<04"
+		}
+	}`)
+
+	var extraction onfido.Extraction
+	err := json.Unmarshal(body, &extraction)
+	assert.NoErrorf(t, err, expectedNoError, "Unmarshal", err)
+
+	assert.Equal(t, onfido.DocumentTypePassport, extraction.DocumentClassification.DocumentType)
+	assert.Equal(t, "GBR", extraction.DocumentClassification.IssuingCountry)
+	assert.Equal(t, "123456789", extraction.ExtractedData.DocumentNumber)
+	assert.Equal(t, "Jane", extraction.ExtractedData.FirstName)
+	assert.Equal(t, "1990-01-01", extraction.ExtractedData.DateOfBirth)
+	assert.Len(t, extraction.ExtractedData.MRZLines(), 2, "expected only the non-empty mrz lines")
+}
@@ -0,0 +1,103 @@
+package onfido
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ------------------------------------------------------------------
+//                      FACIAL SIMILARITY REPORT
+// ------------------------------------------------------------------
+
+// FacialSimilarityReport is a report whose breakdown is typed for the facial similarity reports.
+//
+// It covers the photo, photo fully auto, video and motion variants, the variant is given by the
+// name of the report and determines which parts of the breakdown are set.
+type FacialSimilarityReport struct {
+	Report
+	Breakdown *FacialSimilarityBreakdown `json:"breakdown,omitempty"`
+}
+
+// UnmarshalJSON decodes the typed breakdown along with the embedded report, whose own UnmarshalJSON
+// would otherwise be the only one called
+func (r *FacialSimilarityReport) UnmarshalJSON(data []byte) error {
+	if err := r.Report.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	var typed struct {
+		Breakdown *FacialSimilarityBreakdown `json:"breakdown,omitempty"`
+	}
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return err
+	}
+
+	r.Breakdown = typed.Breakdown
+	return nil
+}
+
+// FacialSimilarityBreakdown is the breakdown of a facial similarity report
+type FacialSimilarityBreakdown struct {
+	FaceComparison     *FacialSimilarityFaceComparison     `json:"face_comparison,omitempty"`
+	ImageIntegrity     *FacialSimilarityImageIntegrity     `json:"image_integrity,omitempty"`
+	VisualAuthenticity *FacialSimilarityVisualAuthenticity `json:"visual_authenticity,omitempty"`
+}
+
+// FacialSimilarityScoredItem is a breakdown assertion carrying a score
+type FacialSimilarityScoredItem struct {
+	Result     string `json:"result,omitempty"`
+	Properties struct {
+		Score *float64 `json:"score,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+// FacialSimilarityFaceComparison asserts that the face in the capture matches the face on the document
+type FacialSimilarityFaceComparison struct {
+	Result    string `json:"result,omitempty"`
+	Breakdown struct {
+		FaceMatch *FacialSimilarityScoredItem `json:"face_match,omitempty"`
+	} `json:"breakdown,omitempty"`
+}
+
+// FacialSimilarityImageIntegrity asserts that the capture was of sufficient quality to be processed
+type FacialSimilarityImageIntegrity struct {
+	Result    string `json:"result,omitempty"`
+	Breakdown struct {
+		FaceDetected    *ReportBreakdownItem `json:"face_detected,omitempty"`
+		SourceIntegrity *ReportBreakdownItem `json:"source_integrity,omitempty"`
+	} `json:"breakdown,omitempty"`
+}
+
+// FacialSimilarityVisualAuthenticity asserts that the person in the capture is real
+type FacialSimilarityVisualAuthenticity struct {
+	Result    string `json:"result,omitempty"`
+	Breakdown struct {
+		SpoofingDetection *FacialSimilarityScoredItem `json:"spoofing_detection,omitempty"`
+		// LivenessDetected is only set for video reports
+		LivenessDetected *ReportBreakdownItem `json:"liveness_detected,omitempty"`
+	} `json:"breakdown,omitempty"`
+}
+
+// IsFacialSimilarityReport reports whether the report is a facial similarity report, of any variant
+func (r *Report) IsFacialSimilarityReport() bool {
+	switch r.Name {
+	case ReportNameFacialSimilarityPhoto, ReportNameFacialSimilarityPhotoFullyAuto,
+		ReportNameFacialSimilarityVideo, ReportNameFacialSimilarityMotion:
+		return true
+	}
+	return false
+}
+
+// AsFacialSimilarityReport returns the report with its breakdown typed for facial similarity reports.
+// It fails if the report is not a facial similarity report.
+func (r *Report) AsFacialSimilarityReport() (*FacialSimilarityReport, error) {
+	if !r.IsFacialSimilarityReport() {
+		return nil, fmt.Errorf("report %s is a %s report, not a facial similarity report", r.ID, r.Name)
+	}
+
+	var report FacialSimilarityReport
+	if err := r.convert(&report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
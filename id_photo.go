@@ -0,0 +1,241 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// ------------------------------------------------------------------
+//                              ID PHOTO
+// ------------------------------------------------------------------
+
+// IDPhoto represents an ID photo (selfie) of an applicant collected outside of the Onfido SDKs
+type IDPhoto struct {
+	ID           string     `json:"id,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	Href         string     `json:"href,omitempty"`
+	DownloadHref string     `json:"download_href,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	FileType     string     `json:"file_type,omitempty"`
+	FileSize     int        `json:"file_size,omitempty"`
+	Raw          RawJSON    `json:"-"`
+}
+
+func (i *IDPhoto) UnmarshalJSON(data []byte) error {
+	type iDPhoto IDPhoto
+	if err := json.Unmarshal(data, (*iDPhoto)(i)); err != nil {
+		return err
+	}
+
+	i.Raw = newRawJSON(data)
+	return nil
+}
+
+type UploadIDPhotoPayload struct {
+	ApplicantID string   `json:"applicant_id,omitempty"`
+	File        *os.File `json:"file,omitempty"`
+}
+
+func (uip UploadIDPhotoPayload) toMultipartMap() (map[string]interface{}, error) {
+	file := uip.File
+
+	uip.File = nil
+	ub, err := json.Marshal(uip)
+	if err != nil {
+		return nil, err
+	}
+
+	var um map[string]interface{}
+	if err := json.Unmarshal(ub, &um); err != nil {
+		return nil, err
+	}
+
+	um["file"] = file
+	return um, nil
+}
+
+// ------------------------------------------------------------------
+//                              OPTIONS
+// ------------------------------------------------------------------
+
+type IsListIDPhotoOption interface {
+	isListIDPhotoOption()
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// UploadIDPhoto uploads an ID photo to the Onfido API
+func (c *Client) UploadIDPhoto(ctx context.Context, payload UploadIDPhotoPayload) (*IDPhoto, error) {
+	var idPhoto IDPhoto
+
+	req := func() error {
+		body, err := c.buildMultipart(payload)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.Post(ctx, "/id_photos", body, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &idPhoto)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &idPhoto, nil
+}
+
+// RetrieveIDPhoto retrieves an ID photo from the Onfido API
+func (c *Client) RetrieveIDPhoto(ctx context.Context, idPhotoId string) (*IDPhoto, error) {
+	if idPhotoId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var idPhoto IDPhoto
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/id_photos/"+idPhotoId, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &idPhoto)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &idPhoto, nil
+}
+
+// ListIDPhotos retrieves the ID photos of an applicant from the Onfido API
+func (c *Client) ListIDPhotos(ctx context.Context, applicantId string, opts ...IsListIDPhotoOption) ([]IDPhoto, *PageDetails, error) {
+	if applicantId == "" {
+		return nil, nil, ErrInvalidId
+	}
+
+	var idPhotos []IDPhoto
+	var pageDetails PageDetails
+
+	req := func() error {
+		params := c.getListIDPhotoParams(applicantId, opts...)
+
+		resp, err := c.client.Get(ctx, "/id_photos", c.getHttpRequestOptions(ctx, params, nil)...)
+		if err != nil {
+			return err
+		}
+
+		var list struct {
+			IDPhotos []IDPhoto `json:"id_photos"`
+		}
+		if err := c.getResponseOrError(resp, &list); err != nil {
+			return err
+		}
+
+		idPhotos = list.IDPhotos
+		pageDetails = c.extractPageDetails(resp.Headers)
+		return c.checkPageRange(params, len(idPhotos), pageDetails)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, nil, err
+	}
+
+	return idPhotos, &pageDetails, nil
+}
+
+// DownloadIDPhoto downloads the image of an ID photo from the Onfido API
+func (c *Client) DownloadIDPhoto(ctx context.Context, idPhotoId string) (*Download, error) {
+	if idPhotoId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var idPhoto *Download
+
+	req := func() error {
+		data, err := c.download(ctx, "/id_photos/"+idPhotoId+"/download")
+		if err != nil {
+			return err
+		}
+
+		if len(data.Data) == 0 {
+			return fmt.Errorf("unable to download id photo")
+		}
+
+		idPhoto = data
+
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return idPhoto, nil
+}
+
+// DownloadIDPhotoStream downloads the image of an ID photo from the Onfido API without buffering it, the caller must close the stream
+func (c *Client) DownloadIDPhotoStream(ctx context.Context, idPhotoId string) (*DownloadStream, error) {
+	if idPhotoId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var stream *DownloadStream
+
+	req := func() error {
+		body, err := c.downloadStream(ctx, "/id_photos/"+idPhotoId+"/download")
+		if err != nil {
+			return err
+		}
+
+		stream = body
+
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// DownloadIDPhotoTo writes the image of an ID photo to w and returns the number of bytes written.
+//   - The download is not retried once writing to w has started
+func (c *Client) DownloadIDPhotoTo(ctx context.Context, idPhotoId string, w io.Writer) (int64, error) {
+	stream, err := c.DownloadIDPhotoStream(ctx, idPhotoId)
+	if err != nil {
+		return 0, err
+	}
+
+	return stream.copyTo(w)
+}
+
+func (c Client) getListIDPhotoParams(applicantId string, opts ...IsListIDPhotoOption) (params map[string]string) {
+	pg, lm := paginationOption{}, limitPaginationOption{}
+
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case PaginationOption:
+			opt(&pg)
+		case LimitPaginationOption:
+			opt(&lm)
+		}
+	}
+
+	params = c.getPaginationOptions(pg, lm)
+	params["applicant_id"] = applicantId
+
+	return
+}
@@ -0,0 +1,200 @@
+package onfido_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDPhoto(t *testing.T) {
+	run := setupTestRun(t)
+	defer run.teardown()
+
+	applicant, err := run.client.CreateApplicant(run.ctx, onfido.CreateApplicantPayload{
+		FirstName: "John",
+		LastName:  "IDPhotoTest",
+	})
+	if err != nil {
+		t.Fatalf("error creating applicant: %v", err)
+	}
+
+	file, err := os.Open("./test/medias/face.png")
+	if err != nil {
+		t.Fatalf("error reading file: %v", err)
+	}
+	defer file.Close()
+
+	testIDPhoto := &onfido.IDPhoto{}
+
+	t.Run("UploadIDPhoto", testUploadIDPhoto(run, applicant.ID, file, testIDPhoto))
+	if testIDPhoto.ID == "" {
+		t.Fatalf("id photo ID is empty")
+	}
+	t.Run("RetrieveIDPhoto", testRetrieveIDPhoto(run, testIDPhoto.ID))
+	t.Run("ListIDPhotos", testListIDPhotos(run, applicant.ID, testIDPhoto.ID))
+	t.Run("DownloadIDPhoto", testDownloadIDPhoto(run, testIDPhoto.ID))
+}
+
+func testUploadIDPhoto(run *testRun, applicantID string, file *os.File, setIDPhoto *onfido.IDPhoto) func(*testing.T) {
+	tests := []testCase[onfido.UploadIDPhotoPayload]{
+		{
+			name: "UploadWithoutErrors",
+			input: onfido.UploadIDPhotoPayload{
+				ApplicantID: applicantID,
+				File:        file,
+			},
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				idPhoto, err := run.client.UploadIDPhoto(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+				if err != nil {
+					t.Fatalf("error uploading id photo: %v", err)
+				}
+
+				// Set the id photo for later tests
+				*setIDPhoto = *idPhoto
+
+				assert.NotEmpty(t, idPhoto.ID, "expected id photo ID to be set")
+				assert.NotEmpty(t, idPhoto.Href, "expected id photo href to be set")
+				assert.NotEmpty(t, idPhoto.DownloadHref, "expected id photo download href to be set")
+			})
+		}
+	}
+}
+
+func testRetrieveIDPhoto(run *testRun, idPhotoId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "RetrieveWithoutErrors",
+			input: idPhotoId,
+		},
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				idPhoto, err := run.client.RetrieveIDPhoto(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotNil(t, idPhoto, "expected id photo to be fetched")
+				assert.Equal(t, tt.input, idPhoto.ID, "expected id photo ID to match")
+			})
+		}
+	}
+}
+
+func testListIDPhotos(run *testRun, applicantId, idPhotoId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "ListWithoutErrors",
+			input: applicantId,
+		},
+		{
+			name:    "ReturnErrorOnEmptyApplicantID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				idPhotos, page, err := run.client.ListIDPhotos(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotNil(t, page, "expected page details to be fetched")
+
+				found := false
+				for _, idPhoto := range idPhotos {
+					if idPhoto.ID == idPhotoId {
+						found = true
+					}
+				}
+				assert.True(t, found, "expected uploaded id photo to be listed")
+			})
+		}
+	}
+}
+
+func testDownloadIDPhoto(run *testRun, idPhotoId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "DownloadWithoutErrors",
+			input: idPhotoId,
+		},
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				idPhoto, err := run.client.DownloadIDPhoto(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				if !assert.NotNil(t, idPhoto, "expected id photo content to be downloaded") {
+					return
+				}
+				assert.NotEmpty(t, idPhoto.Data, "expected id photo content to not be empty")
+
+				if os.Getenv("SAVE_FILES") == "true" {
+					now := time.Now().Unix()
+					saveFile(t, idPhoto.Data, fmt.Sprintf("id-photo-%s-%d.png", tt.input, now))
+				}
+			})
+		}
+	}
+}
@@ -4,25 +4,55 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
-
-	"github.com/besafe-labs/onfido-go-sdk/internal/utils"
 )
 
+// ErrResponseTooLarge is returned when a buffered response body exceeds the maximum response size
+var ErrResponseTooLarge = errors.New("response body too large")
+
 type HttpClient struct {
-	baseURL string
-	client  *http.Client
-	headers http.Header
+	baseURL          string
+	client           *http.Client
+	headers          http.Header
+	onBackoff        BackoffFunc
+	hooks            []RequestHook
+	maxResponseSize  int64
+	logger           Logger
+	logArgs          func(ctx context.Context) []any
+	requestLogLevel  slog.Level
+	retryLogLevel    slog.Level
+	maxRetryWait     time.Duration
+	maxRetryDuration time.Duration
+	authorization    func(ctx context.Context) (string, error)
+}
+
+// Logger receives the internal diagnostics of the client, it is satisfied by *slog.Logger
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
 }
 
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...any) {}
+func (noopLogger) Info(string, ...any)  {}
+func (noopLogger) Warn(string, ...any)  {}
+func (noopLogger) Error(string, ...any) {}
+
+// DefaultMaxRetryWait is the longest the client waits before retrying a request, whatever the server asks for
+const DefaultMaxRetryWait = time.Minute
+
 // Create a new HTTP client
 func NewHttpClient(baseURL string, opts ...ClientOption) *HttpClient {
 	client := &http.Client{
@@ -36,6 +66,11 @@ func NewHttpClient(baseURL string, opts ...ClientOption) *HttpClient {
 		baseURL: baseURL,
 		client:  client,
 		headers: make(http.Header),
+		logger:  noopLogger{},
+
+		requestLogLevel: slog.LevelDebug,
+		retryLogLevel:   slog.LevelWarn,
+		maxRetryWait:    DefaultMaxRetryWait,
 	}
 
 	for _, opt := range opts {
@@ -54,6 +89,15 @@ func WithHttpTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
+// WithHttpTransport sets the transport of the underlying HTTP client, nil keeps the default transport
+func WithHttpTransport(transport http.RoundTripper) ClientOption {
+	return func(c *HttpClient) {
+		if transport != nil {
+			c.client.Transport = transport
+		}
+	}
+}
+
 func WithHttpHeaders(headers http.Header) ClientOption {
 	return func(c *HttpClient) {
 		if c.headers == nil {
@@ -65,6 +109,91 @@ func WithHttpHeaders(headers http.Header) ClientOption {
 	}
 }
 
+func WithHttpLogger(logger Logger) ClientOption {
+	return func(c *HttpClient) {
+		if logger != nil {
+			c.logger = logger
+		}
+	}
+}
+
+// WithHttpMaxRetryWait caps the wait before each retry, a wait of 0 means no limit
+func WithHttpMaxRetryWait(wait time.Duration) ClientOption {
+	return func(c *HttpClient) {
+		c.maxRetryWait = wait
+	}
+}
+
+// WithHttpAuthorization sets the Authorization header of every request to the value returned by fn,
+// requests are not sent when it fails
+func WithHttpAuthorization(fn func(ctx context.Context) (string, error)) ClientOption {
+	return func(c *HttpClient) {
+		c.authorization = fn
+	}
+}
+
+// WithHttpMaxRetryDuration bounds the time spent on a request and its retries, a duration of 0 means no limit
+func WithHttpMaxRetryDuration(d time.Duration) ClientOption {
+	return func(c *HttpClient) {
+		c.maxRetryDuration = d
+	}
+}
+
+// WithHttpLogLevels sets the levels at which completed requests and retries are logged,
+// requests failing without a response are always logged as errors
+func WithHttpLogLevels(request, retry slog.Level) ClientOption {
+	return func(c *HttpClient) {
+		c.requestLogLevel = request
+		c.retryLogLevel = retry
+	}
+}
+
+// WithHttpLogArgs adds the key-value pairs returned by fn for the request context to every log
+func WithHttpLogArgs(fn func(ctx context.Context) []any) ClientOption {
+	return func(c *HttpClient) {
+		c.logArgs = fn
+	}
+}
+
+// WithHttpMaxResponseSize caps the size of buffered response bodies, a size of 0 means no limit
+func WithHttpMaxResponseSize(size int64) ClientOption {
+	return func(c *HttpClient) {
+		c.maxResponseSize = size
+	}
+}
+
+// BackoffFunc is called before the client sleeps between two attempts of a request
+type BackoffFunc func(attempt int, wait time.Duration, cause error)
+
+func WithHttpBackoffHook(hook BackoffFunc) ClientOption {
+	return func(c *HttpClient) {
+		c.onBackoff = hook
+	}
+}
+
+// RequestIDHeader is the response header holding the identifier given by the API to a request
+const RequestIDHeader = "X-Request-Id"
+
+// RequestInfo describes a completed request, including all of its retries
+type RequestInfo struct {
+	Method     string
+	Path       string
+	StatusCode int
+	RequestID  string
+	Duration   time.Duration
+	Attempts   int
+	Err        error
+}
+
+// RequestHook is called once a request completes, successfully or not
+type RequestHook func(ctx context.Context, info RequestInfo)
+
+func WithHttpRequestHook(hook RequestHook) ClientOption {
+	return func(c *HttpClient) {
+		c.hooks = append(c.hooks, hook)
+	}
+}
+
 // Request options
 
 type requestOptions struct {
@@ -74,6 +203,7 @@ type requestOptions struct {
 	timeout     time.Duration
 	retries     int
 	retryWait   time.Duration
+	retryPolicy RetryPolicy
 }
 
 type formDataEntry struct {
@@ -84,13 +214,14 @@ type formDataEntry struct {
 
 type RequestOption func(*requestOptions)
 
+// WithHttpQueryParams sets query parameters on the request, overriding the ones set by a previous option
 func WithHttpQueryParams(params map[string]string) RequestOption {
 	return func(o *requestOptions) {
 		if o.queryParams == nil {
 			o.queryParams = make(url.Values)
 		}
 		for k, v := range params {
-			o.queryParams.Add(k, v)
+			o.queryParams.Set(k, v)
 		}
 	}
 }
@@ -102,6 +233,23 @@ func WithHttpRetries(retries int, wait time.Duration) RequestOption {
 	}
 }
 
+// RetryPolicy decides, after the given attempt, whether a request is retried and how long to wait before
+type RetryPolicy func(resp *http.Response, err error, attempt int) (time.Duration, bool)
+
+// WithHttpRetryPolicy retries the request as decided by policy instead of the retries count and wait
+func WithHttpRetryPolicy(policy RetryPolicy) RequestOption {
+	return func(o *requestOptions) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithHttpRequestTimeout bounds the whole request, retries and response body included
+func WithHttpRequestTimeout(timeout time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = timeout
+	}
+}
+
 func WithRequestHttpHeaders(headers http.Header) RequestOption {
 	return func(o *requestOptions) {
 		if o.headers == nil {
@@ -165,6 +313,45 @@ func (c *HttpClient) Delete(ctx context.Context, path string, opts ...RequestOpt
 	return c.doRequest(ctx, http.MethodDelete, path, nil, opts...)
 }
 
+// GetStream sends a GET request and returns the response without buffering its body,
+// it is not subject to the maximum response size
+func (c *HttpClient) GetStream(ctx context.Context, path string, opts ...RequestOption) (*HttpStreamResponse, error) {
+	resp, err := c.send(ctx, http.MethodGet, path, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HttpStreamResponse{
+		Status:        resp.Status,
+		StatusCode:    resp.StatusCode,
+		Headers:       resp.Header,
+		Body:          resp.Body,
+		ContentLength: resp.ContentLength,
+	}, nil
+}
+
+// GetURLStream sends a GET request to an absolute URL, e.g. a pre-signed file URL, and returns
+// the response without buffering its body. The client headers are not sent and the request is not retried.
+func (c *HttpClient) GetURLStream(ctx context.Context, rawURL string) (*HttpStreamResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HttpStreamResponse{
+		Status:        resp.Status,
+		StatusCode:    resp.StatusCode,
+		Headers:       resp.Header,
+		Body:          resp.Body,
+		ContentLength: resp.ContentLength,
+	}, nil
+}
+
 // Close closes the idle connections of the underlying HTTP client.
 //
 // The client can be reused after closing as per the [http.Client] documentation.
@@ -173,6 +360,63 @@ func (c *HttpClient) Close() {
 }
 
 func (c *HttpClient) doRequest(ctx context.Context, method, path string, body isHttpBody, opts ...RequestOption) (*HttpResponse, error) {
+	resp, err := c.send(ctx, method, path, body, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := c.readBody(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HttpResponse{
+		Status:     resp.Status,
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header,
+		Body:       respBody,
+		Request:    resp.Request,
+	}
+
+	return response, nil
+}
+
+// readBody reads the whole body, failing with ErrResponseTooLarge if it exceeds the maximum response size
+func (c *HttpClient) readBody(body io.Reader) ([]byte, error) {
+	if c.maxResponseSize > 0 {
+		body = io.LimitReader(body, c.maxResponseSize+1)
+	}
+
+	respBody, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if c.maxResponseSize > 0 && int64(len(respBody)) > c.maxResponseSize {
+		return nil, fmt.Errorf("%w: limit is %d bytes", ErrResponseTooLarge, c.maxResponseSize)
+	}
+
+	return respBody, nil
+}
+
+// send sends the request, retrying it as configured, and returns the response with its body unread
+func (c *HttpClient) send(ctx context.Context, method, path string, body isHttpBody, opts ...RequestOption) (response *http.Response, err error) {
+	info := RequestInfo{Method: method, Path: path}
+	start, hookCtx := time.Now(), ctx
+	defer func() {
+		info.Duration = time.Since(start)
+		info.Err = err
+		if response != nil {
+			info.StatusCode = response.StatusCode
+			info.RequestID = response.Header.Get(RequestIDHeader)
+		}
+		c.logRequest(hookCtx, info)
+		for _, hook := range c.hooks {
+			hook(hookCtx, info)
+		}
+	}()
+
 	options := &requestOptions{
 		headers: make(http.Header),
 	}
@@ -186,6 +430,19 @@ func (c *HttpClient) doRequest(ctx context.Context, method, path string, body is
 		options.retryWait = 2 * time.Second
 	}
 
+	if options.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.timeout)
+		defer func() {
+			// the timeout must keep running while the caller reads the response body
+			if err != nil {
+				cancel()
+				return
+			}
+			response.Body = &cancelOnClose{ReadCloser: response.Body, cancel: cancel}
+		}()
+	}
+
 	reqURL, err := url.Parse(c.baseURL + path)
 	if err != nil {
 		return nil, fmt.Errorf("invalid URL: %w", err)
@@ -229,6 +486,13 @@ func (c *HttpClient) doRequest(ctx context.Context, method, path string, body is
 	for k, v := range c.headers {
 		req.Header[k] = v
 	}
+	if c.authorization != nil {
+		authorization, err := c.authorization(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", authorization)
+	}
 	for k, v := range options.headers {
 		req.Header[k] = v
 	}
@@ -237,30 +501,51 @@ func (c *HttpClient) doRequest(ctx context.Context, method, path string, body is
 	var resp *http.Response
 	var lastErr error
 
-	for attempt := 0; attempt <= options.retries; attempt++ {
-		// if attempt is not first trial, wait for retryWait time
+	var wait time.Duration
+	retryStart := time.Now()
+	for attempt := 0; ; attempt++ {
+		// if attempt is not first trial, wait before retrying
 		if attempt > 0 {
-			// For 429, try to use Retry-After header if available
-			if resp != nil && resp.StatusCode == 429 {
-				if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
-					if seconds, err := strconv.Atoi(retryAfter); err == nil {
-						time.Sleep(time.Duration(seconds) * time.Second)
-						continue
-					}
-				}
+			if c.onBackoff != nil {
+				c.onBackoff(attempt, wait, retryCause(resp, lastErr))
+			}
+
+			// the wait is cut short when the context ends, a call timeout bounds the retries too
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
 			}
-			time.Sleep(options.retryWait)
 		}
 
-		resp, lastErr = c.client.Do(req)
-		// if request is not successful and retries are not enabled or max retries reached, break the loop
-		if !shouldRetry(resp, lastErr) || attempt >= options.retries {
+		attemptReq, err := attemptRequest(ctx, req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, lastErr = c.client.Do(attemptReq)
+		info.Attempts = attempt + 1
+
+		// a request whose context ended can't succeed on a later attempt
+		if ctx.Err() != nil {
 			break
 		}
 
-		if utils.IsTestRun() {
-			log.Printf("\033[33m retrying request %s %s, attempt %d\033[0m\n", method, reqURL.String(), attempt+1)
+		var retry bool
+		if wait, retry = c.nextRetry(options, resp, lastErr, attempt+1); !retry {
+			break
+		}
+		if c.maxRetryWait > 0 && wait > c.maxRetryWait {
+			wait = c.maxRetryWait
 		}
+		// stop retrying once the next attempt would start past the retry budget
+		if c.maxRetryDuration > 0 && time.Since(retryStart)+wait > c.maxRetryDuration {
+			break
+		}
+
+		c.log(c.retryLogLevel, "retrying request", c.withLogArgs(ctx, "method", method, "url", reqURL.String(), "attempt", attempt+1, "wait", wait, "cause", retryCause(resp, lastErr))...)
 
 		// Close the response body if the request is going to be retried
 		if lastErr == nil {
@@ -269,24 +554,42 @@ func (c *HttpClient) doRequest(ctx context.Context, method, path string, body is
 	}
 
 	if lastErr != nil {
-		return nil, fmt.Errorf("request failed after %d retries: %w", options.retries, lastErr)
+		return nil, fmt.Errorf("request failed after %d retries: %w", info.Attempts-1, lastErr)
 	}
-	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
+	return resp, nil
+}
 
-	response := &HttpResponse{
-		Status:     resp.Status,
-		StatusCode: resp.StatusCode,
-		Headers:    resp.Header,
-		Body:       respBody,
-		Request:    resp.Request,
+// cancelOnClose releases the context of a request once its response body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// HttpStreamResponse is a response whose body is streamed instead of buffered.
+// The caller is responsible for closing the body.
+type HttpStreamResponse struct {
+	Status     string
+	StatusCode int
+	Headers    http.Header
+	Body       io.ReadCloser
+	// ContentLength is the length of the body, or -1 when unknown
+	ContentLength int64
+}
+
+// Buffer reads the whole body of a streamed response within the maximum response size
+func (c *HttpClient) Buffer(r *HttpStreamResponse) (*HttpResponse, error) {
+	body, err := c.readBody(r.Body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return &HttpResponse{Status: r.Status, StatusCode: r.StatusCode, Headers: r.Headers, Body: body}, nil
 }
 
 type HttpResponse struct {
@@ -305,6 +608,145 @@ func (r *HttpResponse) String() string {
 	return string(r.Body)
 }
 
+// logRequest logs a completed request
+func (c *HttpClient) logRequest(ctx context.Context, info RequestInfo) {
+	args := []any{"method", info.Method, "path", info.Path, "duration", info.Duration, "attempts", info.Attempts}
+	if info.Err != nil {
+		c.log(slog.LevelError, "request failed", c.withLogArgs(ctx, append(args, "error", info.Err)...)...)
+		return
+	}
+	c.log(c.requestLogLevel, "request completed", c.withLogArgs(ctx, append(args, "status", info.StatusCode, "request_id", info.RequestID)...)...)
+}
+
+// log logs msg with the method of the logger matching level
+func (c *HttpClient) log(level slog.Level, msg string, args ...any) {
+	switch {
+	case level < slog.LevelInfo:
+		c.logger.Debug(msg, args...)
+	case level < slog.LevelWarn:
+		c.logger.Info(msg, args...)
+	case level < slog.LevelError:
+		c.logger.Warn(msg, args...)
+	default:
+		c.logger.Error(msg, args...)
+	}
+}
+
+// withLogArgs appends the log args of the request context to args
+func (c *HttpClient) withLogArgs(ctx context.Context, args ...any) []any {
+	if c.logArgs == nil {
+		return args
+	}
+	return append(args, c.logArgs(ctx)...)
+}
+
+// retryCause returns the error that caused a request to be retried
+func retryCause(resp *http.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("unexpected response status: %s", resp.Status)
+}
+
+// attemptRequest returns the request to send for the given attempt: retries are sent with a fresh copy of
+// the body, since the body of the previous attempt was consumed
+func attemptRequest(ctx context.Context, req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody == nil {
+		return nil, errors.New("failed to retry request: body can't be rebuilt")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild request body: %w", err)
+	}
+
+	retry := req.Clone(ctx)
+	retry.Body = body
+	return retry, nil
+}
+
+// nextRetry decides whether a request is retried after the given attempt, and how long to wait before
+func (c *HttpClient) nextRetry(options *requestOptions, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if options.retryPolicy != nil {
+		return options.retryPolicy(c.inspectableResponse(resp), err, attempt)
+	}
+
+	// if request is not successful and retries are not enabled or max retries reached, don't retry
+	if !shouldRetry(resp, err) || attempt > options.retries {
+		return 0, false
+	}
+
+	wait := options.retryWait
+	// For 429, try to use Retry-After header if available
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		if retryAfter, ok := RetryAfter(resp, time.Now()); ok {
+			wait = retryAfter
+		}
+	}
+	return wait, true
+}
+
+// RetryAfter returns the delay requested by the Retry-After header of resp, given in seconds or as an HTTP date
+func RetryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	retryAfter := resp.Header.Get("Retry-After")
+	if retryAfter == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(retryAfter)
+	if err != nil {
+		return 0, false
+	}
+	if wait := date.Sub(now); wait > 0 {
+		return wait, true
+	}
+	return 0, true
+}
+
+// maxInspectedBodySize caps the error bodies buffered for retry policies, which only need the error envelope
+const maxInspectedBodySize = 64 << 10
+
+// inspectableResponse buffers the body of an error response, up to 64 KiB or the maximum response size, so that
+// a retry policy can read it without consuming it, successful responses are passed as is to keep downloads streamed
+func (c *HttpClient) inspectableResponse(resp *http.Response) *http.Response {
+	if resp == nil || resp.StatusCode < http.StatusBadRequest {
+		return resp
+	}
+
+	limit := int64(maxInspectedBodySize)
+	if c.maxResponseSize > 0 && c.maxResponseSize < limit {
+		limit = c.maxResponseSize
+	}
+
+	buffered, err := io.ReadAll(io.LimitReader(resp.Body, limit))
+	// the part of the body beyond the limit is left unread, reading the response still fails once it is too large
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(buffered), resp.Body), resp.Body}
+	if err != nil {
+		return resp
+	}
+
+	inspected := *resp
+	inspected.Body = io.NopCloser(bytes.NewReader(buffered))
+	return &inspected
+}
+
 func shouldRetry(resp *http.Response, err error) bool {
 	if err != nil {
 		return true
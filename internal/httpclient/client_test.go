@@ -0,0 +1,406 @@
+package httpclient
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHttpClient(t *testing.T) {
+	t.Run("BackoffHook", testBackoffHook)
+	t.Run("RequestHook", testRequestHook)
+	t.Run("MaxResponseSize", testMaxResponseSize)
+	t.Run("Logger", testLogger)
+	t.Run("GetURLStream", testGetURLStream)
+	t.Run("RequestTimeout", testRequestTimeout)
+	t.Run("RetryAfter", testRetryAfter)
+	t.Run("RetryBodies", testRetryBodies)
+}
+
+func testBackoffHook(t *testing.T) {
+	t.Run("CalledBeforeEveryRetry", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		var attempts []int
+		var causes []error
+		client := NewHttpClient(server.URL, WithHttpBackoffHook(func(attempt int, wait time.Duration, cause error) {
+			attempts = append(attempts, attempt)
+			causes = append(causes, cause)
+			assert.Equal(t, time.Millisecond, wait, "expected wait to match configured retry wait")
+		}))
+
+		resp, err := client.Get(context.Background(), "/", WithHttpRetries(2, time.Millisecond))
+		assert.NoError(t, err, "expected no error")
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "expected last response to be returned")
+		assert.Equal(t, []int{1, 2}, attempts, "expected hook to be called for each retry")
+		for _, cause := range causes {
+			assert.ErrorContains(t, cause, "503", "expected cause to describe the response status")
+		}
+	})
+
+	t.Run("UsesRetryAfterHeader", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		var waits []time.Duration
+		client := NewHttpClient(server.URL, WithHttpBackoffHook(func(attempt int, wait time.Duration, cause error) {
+			waits = append(waits, wait)
+		}))
+
+		resp, err := client.Get(context.Background(), "/", WithHttpRetries(1, time.Hour))
+		assert.NoError(t, err, "expected no error")
+		assert.Equal(t, http.StatusOK, resp.StatusCode, "expected request to succeed after retry")
+		assert.Equal(t, []time.Duration{0}, waits, "expected wait to come from Retry-After header")
+	})
+
+	t.Run("CapRetryWait", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.Header().Set("Retry-After", "3600")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		var waits []time.Duration
+		client := NewHttpClient(server.URL, WithHttpMaxRetryWait(time.Millisecond), WithHttpBackoffHook(func(attempt int, wait time.Duration, cause error) {
+			waits = append(waits, wait)
+		}))
+
+		resp, err := client.Get(context.Background(), "/", WithHttpRetries(1, time.Hour))
+		assert.NoError(t, err, "expected no error")
+		assert.Equal(t, http.StatusOK, resp.StatusCode, "expected request to succeed after retry")
+		assert.Equal(t, []time.Duration{time.Millisecond}, waits, "expected wait to be capped")
+	})
+
+	t.Run("StopRetryingPastMaxRetryDuration", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client := NewHttpClient(server.URL, WithHttpMaxRetryDuration(50*time.Millisecond))
+
+		start := time.Now()
+		resp, err := client.Get(context.Background(), "/", WithHttpRetries(100, 20*time.Millisecond))
+		assert.NoError(t, err, "expected no error")
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "expected last response to be returned")
+		assert.Less(t, time.Since(start), 200*time.Millisecond, "expected retries to stop within the budget")
+		assert.Less(t, calls, 5, "expected retries to be bounded by the budget")
+	})
+}
+
+func testRequestHook(t *testing.T) {
+	t.Run("CalledOnceWithRetriesIncluded", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		var infos []RequestInfo
+		client := NewHttpClient(server.URL, WithHttpRequestHook(func(ctx context.Context, info RequestInfo) {
+			infos = append(infos, info)
+		}))
+
+		_, err := client.Get(context.Background(), "/applicants", WithHttpRetries(2, time.Millisecond))
+		assert.NoError(t, err, "expected no error")
+		if assert.Len(t, infos, 1, "expected hook to be called once") {
+			assert.Equal(t, http.MethodGet, infos[0].Method)
+			assert.Equal(t, "/applicants", infos[0].Path)
+			assert.Equal(t, http.StatusBadGateway, infos[0].StatusCode)
+			assert.Equal(t, 3, infos[0].Attempts, "expected all attempts to be counted")
+			assert.NoError(t, infos[0].Err)
+		}
+	})
+}
+
+func testMaxResponseSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("0123456789"))
+	}))
+	defer server.Close()
+
+	t.Run("ReturnErrorAboveLimit", func(t *testing.T) {
+		client := NewHttpClient(server.URL, WithHttpMaxResponseSize(5))
+		_, err := client.Get(context.Background(), "/")
+		assert.ErrorIs(t, err, ErrResponseTooLarge, "expected response too large error")
+	})
+
+	t.Run("ReadWithinLimit", func(t *testing.T) {
+		client := NewHttpClient(server.URL, WithHttpMaxResponseSize(10))
+		resp, err := client.Get(context.Background(), "/")
+		assert.NoError(t, err, "expected no error")
+		assert.Equal(t, "0123456789", resp.String())
+	})
+
+	t.Run("BoundRetryPolicyBodies", func(t *testing.T) {
+		errorServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("0123456789"))
+		}))
+		defer errorServer.Close()
+
+		var inspected string
+		policy := WithHttpRetryPolicy(func(resp *http.Response, err error, attempt int) (time.Duration, bool) {
+			body, _ := io.ReadAll(resp.Body)
+			inspected = string(body)
+			return 0, false
+		})
+
+		client := NewHttpClient(errorServer.URL, WithHttpMaxResponseSize(5))
+		_, err := client.Get(context.Background(), "/", policy)
+		assert.Equal(t, "01234", inspected, "expected the retry policy to read the body up to the limit")
+		assert.ErrorIs(t, err, ErrResponseTooLarge, "expected response too large error")
+	})
+
+	t.Run("BoundRetryPolicyBodiesWithoutLimit", func(t *testing.T) {
+		large := strings.Repeat("x", 100<<10)
+		errorServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(large))
+		}))
+		defer errorServer.Close()
+
+		var inspected int
+		policy := WithHttpRetryPolicy(func(resp *http.Response, err error, attempt int) (time.Duration, bool) {
+			body, _ := io.ReadAll(resp.Body)
+			inspected = len(body)
+			return 0, false
+		})
+
+		client := NewHttpClient(errorServer.URL)
+		resp, err := client.Get(context.Background(), "/", policy)
+		assert.NoError(t, err, "expected no error")
+		assert.Equal(t, 64<<10, inspected, "expected the retry policy to read the body up to 64 KiB")
+		assert.Equal(t, large, resp.String(), "expected the whole body to be returned")
+	})
+
+	t.Run("StreamIgnoresLimit", func(t *testing.T) {
+		client := NewHttpClient(server.URL, WithHttpMaxResponseSize(5))
+		resp, err := client.GetStream(context.Background(), "/")
+		if !assert.NoError(t, err, "expected no error") {
+			return
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err, "expected no error reading stream")
+		assert.Equal(t, "0123456789", string(body))
+	})
+}
+
+type recordingLogger struct {
+	noopLogger
+	infos    []string
+	warnings []string
+	errors   []string
+}
+
+func (l *recordingLogger) Info(msg string, args ...any) {
+	l.infos = append(l.infos, msg)
+}
+
+func (l *recordingLogger) Warn(msg string, args ...any) {
+	l.warnings = append(l.warnings, msg)
+}
+
+func (l *recordingLogger) Error(msg string, args ...any) {
+	l.errors = append(l.errors, msg)
+}
+
+func testLogger(t *testing.T) {
+	t.Run("LogRetries", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		logger := &recordingLogger{}
+		client := NewHttpClient(server.URL, WithHttpLogger(logger))
+
+		_, err := client.Get(context.Background(), "/", WithHttpRetries(2, time.Millisecond))
+		assert.NoError(t, err, "expected no error")
+		assert.Equal(t, []string{"retrying request", "retrying request"}, logger.warnings, "expected a warning for each retry")
+	})
+
+	t.Run("LogRequestsAtConfiguredLevels", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		logger := &recordingLogger{}
+		client := NewHttpClient(server.URL, WithHttpLogger(logger), WithHttpLogLevels(slog.LevelInfo, slog.LevelError))
+
+		_, err := client.Get(context.Background(), "/", WithHttpRetries(1, time.Millisecond))
+		assert.NoError(t, err, "expected no error")
+		assert.Equal(t, []string{"request completed"}, logger.infos, "expected completed request to be logged at the request level")
+		assert.Equal(t, []string{"retrying request"}, logger.errors, "expected retry to be logged at the retry level")
+		assert.Empty(t, logger.warnings, "expected no warning")
+	})
+
+	t.Run("LogFailedRequestsAsErrors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		logger := &recordingLogger{}
+		client := NewHttpClient(server.URL, WithHttpLogger(logger))
+
+		_, err := client.Get(context.Background(), "/")
+		assert.Error(t, err, "expected an error")
+		assert.Equal(t, []string{"request failed"}, logger.errors, "expected failed request to be logged as an error")
+	})
+}
+
+func testGetURLStream(t *testing.T) {
+	t.Run("SkipClientHeaders", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get("Authorization"), "expected client headers not to be sent")
+			w.Write([]byte("file"))
+		}))
+		defer server.Close()
+
+		headers := make(http.Header)
+		headers.Set("Authorization", "Token token=secret")
+		client := NewHttpClient("https://api.example.com", WithHttpHeaders(headers))
+
+		resp, err := client.GetURLStream(context.Background(), server.URL+"/signed")
+		if !assert.NoError(t, err, "expected no error") {
+			return
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err, "expected no error reading stream")
+		assert.Equal(t, "file", string(body))
+	})
+}
+
+func testRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := NewHttpClient(server.URL)
+
+	t.Run("ReturnErrorWhenExceeded", func(t *testing.T) {
+		_, err := client.Get(context.Background(), "/slow", WithHttpRequestTimeout(20*time.Millisecond))
+		assert.ErrorIs(t, err, context.DeadlineExceeded, "expected request to time out")
+	})
+
+	t.Run("KeepStreamReadableUntilClosed", func(t *testing.T) {
+		resp, err := client.GetStream(context.Background(), "/fast", WithHttpRequestTimeout(time.Second))
+		if !assert.NoError(t, err, "expected no error") {
+			return
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err, "expected no error reading stream")
+		assert.Equal(t, "ok", string(body))
+	})
+}
+
+func testRetryAfter(t *testing.T) {
+	now := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+		ok     bool
+	}{
+		{name: "ParseSeconds", header: "120", want: 2 * time.Minute, ok: true},
+		{name: "ParseHTTPDate", header: "Sat, 01 Mar 2025 12:00:30 GMT", want: 30 * time.Second, ok: true},
+		{name: "ReturnZeroForPastDate", header: "Sat, 01 Mar 2025 11:00:00 GMT", want: 0, ok: true},
+		{name: "IgnoreNegativeSeconds", header: "-1"},
+		{name: "IgnoreInvalidValue", header: "soon"},
+		{name: "IgnoreMissingHeader"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: make(http.Header)}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+
+			wait, ok := RetryAfter(resp, now)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, wait)
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func testRetryBodies(t *testing.T) {
+	// the transport reads the body of every attempt, like a server would, and fails the first one
+	recordBodies := func(bodies *[]string) ClientOption {
+		return WithHttpTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			*bodies = append(*bodies, string(body))
+			status := http.StatusOK
+			if len(*bodies) == 1 {
+				status = http.StatusServiceUnavailable
+			}
+			return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+		}))
+	}
+
+	t.Run("ResendJSONBody", func(t *testing.T) {
+		var bodies []string
+		client := NewHttpClient("https://api.example.com", recordBodies(&bodies))
+
+		_, err := client.Post(context.Background(), "/", JsonBody{"first_name": "John"}, WithHttpRetries(1, time.Millisecond))
+		assert.NoError(t, err, "expected no error")
+		assert.Equal(t, []string{`{"first_name":"John"}`, `{"first_name":"John"}`}, bodies, "expected retry to resend the body")
+	})
+
+	t.Run("ResendMultipartBody", func(t *testing.T) {
+		var bodies []string
+		client := NewHttpClient("https://api.example.com", recordBodies(&bodies))
+
+		body := NewMultipartBody()
+		assert.NoError(t, body.WriteField("type", "passport"))
+
+		_, err := client.Post(context.Background(), "/", body, WithHttpRetries(1, time.Millisecond))
+		assert.NoError(t, err, "expected no error")
+		if assert.Len(t, bodies, 2, "expected a retry") {
+			assert.Contains(t, bodies[1], "passport", "expected retry to resend the multipart body")
+			assert.Equal(t, bodies[0], bodies[1], "expected retry to resend the same body")
+		}
+	})
+}
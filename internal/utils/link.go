@@ -0,0 +1,153 @@
+package utils
+
+import "strings"
+
+// Link is a link of a Link header, as defined by RFC 8288
+type Link struct {
+	// URL is the target of the link, as written in the header
+	URL string
+	// Rels are the relation types of the link, lowercased, e.g. "next"
+	Rels []string
+	// Params are the other parameters of the link, by lowercased name
+	Params map[string]string
+}
+
+// HasRel reports whether the link has the relation type rel
+func (l Link) HasRel(rel string) bool {
+	for _, r := range l.Rels {
+		if r == strings.ToLower(rel) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseLinkHeader parses the links of the values of Link headers, malformed links are skipped
+func ParseLinkHeader(values ...string) []Link {
+	var links []Link
+	for _, value := range values {
+		s := value
+		for {
+			s = strings.TrimLeft(s, " \t,")
+			if s == "" {
+				break
+			}
+
+			link, rest, ok := parseLink(s)
+			if ok {
+				links = append(links, link)
+			}
+			s = rest
+		}
+	}
+	return links
+}
+
+// parseLink parses the link at the start of s and returns the rest of s after the link.
+// A malformed link is skipped up to the next link.
+func parseLink(s string) (link Link, rest string, ok bool) {
+	if s[0] != '<' {
+		return Link{}, skipLink(s), false
+	}
+	end := strings.IndexByte(s, '>')
+	if end < 0 {
+		return Link{}, "", false
+	}
+
+	link = Link{URL: strings.TrimSpace(s[1:end]), Params: make(map[string]string)}
+	s = s[end+1:]
+
+	relSeen := false
+	for {
+		s = strings.TrimLeft(s, " \t")
+		if s == "" || s[0] != ';' {
+			break
+		}
+		s = strings.TrimLeft(s[1:], " \t")
+
+		var name, value string
+		i := strings.IndexAny(s, "=;,")
+		if i < 0 {
+			name, s = s, ""
+		} else {
+			name, s = s[:i], s[i:]
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		if strings.HasPrefix(s, "=") {
+			value, s = parseParamValue(strings.TrimLeft(s[1:], " \t"))
+		}
+		if name == "" {
+			continue
+		}
+
+		// occurrences of rel after the first one must be ignored, as must be duplicate params
+		if name == "rel" {
+			if !relSeen {
+				relSeen = true
+				for _, rel := range strings.Fields(value) {
+					link.Rels = append(link.Rels, strings.ToLower(rel))
+				}
+			}
+			continue
+		}
+		if _, ok := link.Params[name]; !ok {
+			link.Params[name] = value
+		}
+	}
+
+	// anything else up to the next link is garbage
+	s = strings.TrimLeft(s, " \t")
+	if s != "" && s[0] != ',' {
+		return link, skipLink(s), true
+	}
+	return link, s, true
+}
+
+// parseParamValue parses a token or quoted-string parameter value at the start of s
+func parseParamValue(s string) (value, rest string) {
+	if !strings.HasPrefix(s, `"`) {
+		i := strings.IndexAny(s, ";,")
+		if i < 0 {
+			return strings.TrimSpace(s), ""
+		}
+		return strings.TrimSpace(s[:i]), s[i:]
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			}
+		case '"':
+			return b.String(), s[i+1:]
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	// unterminated quoted-string
+	return b.String(), ""
+}
+
+// skipLink returns s after the next comma outside of angle brackets and quoted-strings
+func skipLink(s string) string {
+	inURL, inQuotes := false, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case inQuotes && c == '\\':
+			i++
+		case c == '"' && !inURL:
+			inQuotes = !inQuotes
+		case c == '<' && !inQuotes:
+			inURL = true
+		case c == '>' && !inQuotes:
+			inURL = false
+		case c == ',' && !inURL && !inQuotes:
+			return s[i+1:]
+		}
+	}
+	return ""
+}
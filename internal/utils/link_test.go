@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLinkHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   []Link
+	}{
+		{
+			name: "ParseOnfidoPagination",
+			values: []string{`<https://api.eu.onfido.com/v3.6/applicants?page=1&per_page=20>; rel="first", ` +
+				`<https://api.eu.onfido.com/v3.6/applicants?page=5&per_page=20>; rel="last", ` +
+				`<https://api.eu.onfido.com/v3.6/applicants?page=3&per_page=20>; rel="next", ` +
+				`<https://api.eu.onfido.com/v3.6/applicants?page=1&per_page=20>; rel="prev"`},
+			want: []Link{
+				{URL: "https://api.eu.onfido.com/v3.6/applicants?page=1&per_page=20", Rels: []string{"first"}, Params: map[string]string{}},
+				{URL: "https://api.eu.onfido.com/v3.6/applicants?page=5&per_page=20", Rels: []string{"last"}, Params: map[string]string{}},
+				{URL: "https://api.eu.onfido.com/v3.6/applicants?page=3&per_page=20", Rels: []string{"next"}, Params: map[string]string{}},
+				{URL: "https://api.eu.onfido.com/v3.6/applicants?page=1&per_page=20", Rels: []string{"prev"}, Params: map[string]string{}},
+			},
+		},
+		{
+			name:   "ParseUnquotedAndMultipleRels",
+			values: []string{`<https://api.eu.onfido.com/v3.6/workflow_runs?status=approved&page=2>; REL=Next, <https://api.eu.onfido.com/v3.6/workflow_runs?page=2>;rel="next last"`},
+			want: []Link{
+				{URL: "https://api.eu.onfido.com/v3.6/workflow_runs?status=approved&page=2", Rels: []string{"next"}, Params: map[string]string{}},
+				{URL: "https://api.eu.onfido.com/v3.6/workflow_runs?page=2", Rels: []string{"next", "last"}, Params: map[string]string{}},
+			},
+		},
+		{
+			name:   "ParseQuotedParamsWithSeparators",
+			values: []string{`<https://example.com/a,b>; rel="next"; title="page 2, \"next\""; type=text/html; title="ignored", </c>; rel=last`},
+			want: []Link{
+				{URL: "https://example.com/a,b", Rels: []string{"next"}, Params: map[string]string{"title": `page 2, "next"`, "type": "text/html"}},
+				{URL: "/c", Rels: []string{"last"}, Params: map[string]string{}},
+			},
+		},
+		{
+			name:   "IgnoreRelAfterTheFirst",
+			values: []string{`</a>; rel=next; rel=last`},
+			want:   []Link{{URL: "/a", Rels: []string{"next"}, Params: map[string]string{}}},
+		},
+		{
+			name:   "ParseMultipleHeaderValues",
+			values: []string{`</a>; rel=first`, `</b>; rel=last`},
+			want: []Link{
+				{URL: "/a", Rels: []string{"first"}, Params: map[string]string{}},
+				{URL: "/b", Rels: []string{"last"}, Params: map[string]string{}},
+			},
+		},
+		{
+			name:   "SkipMalformedLinks",
+			values: []string{`https://example.com/a; rel=next, </b>; rel=last, <unterminated`},
+			want:   []Link{{URL: "/b", Rels: []string{"last"}, Params: map[string]string{}}},
+		},
+		{
+			name:   "ReturnNothingForEmptyHeader",
+			values: []string{""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseLinkHeader(tt.values...))
+		})
+	}
+}
+
+func TestLinkHasRel(t *testing.T) {
+	link := Link{Rels: []string{"next", "last"}}
+	assert.True(t, link.HasRel("Last"))
+	assert.False(t, link.HasRel("prev"))
+}
@@ -0,0 +1,243 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// ------------------------------------------------------------------
+//                              LIVE PHOTO
+// ------------------------------------------------------------------
+
+// LivePhoto represents a live photo (selfie) of an applicant in the Onfido API
+type LivePhoto struct {
+	ID           string     `json:"id,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	Href         string     `json:"href,omitempty"`
+	DownloadHref string     `json:"download_href,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	FileType     string     `json:"file_type,omitempty"`
+	FileSize     int        `json:"file_size,omitempty"`
+	Raw          RawJSON    `json:"-"`
+}
+
+func (l *LivePhoto) UnmarshalJSON(data []byte) error {
+	type livePhoto LivePhoto
+	if err := json.Unmarshal(data, (*livePhoto)(l)); err != nil {
+		return err
+	}
+
+	l.Raw = newRawJSON(data)
+	return nil
+}
+
+type UploadLivePhotoPayload struct {
+	ApplicantID string   `json:"applicant_id,omitempty"`
+	File        *os.File `json:"file,omitempty"`
+	// AdvancedValidation validates that the photo contains exactly one face, defaults to true on the API side
+	AdvancedValidation *bool `json:"advanced_validation,omitempty"`
+}
+
+func (ulp UploadLivePhotoPayload) toMultipartMap() (map[string]interface{}, error) {
+	file := ulp.File
+
+	ulp.File = nil
+	ub, err := json.Marshal(ulp)
+	if err != nil {
+		return nil, err
+	}
+
+	var um map[string]interface{}
+	if err := json.Unmarshal(ub, &um); err != nil {
+		return nil, err
+	}
+
+	um["file"] = file
+	return um, nil
+}
+
+// ------------------------------------------------------------------
+//                              OPTIONS
+// ------------------------------------------------------------------
+
+type IsListLivePhotoOption interface {
+	isListLivePhotoOption()
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// UploadLivePhoto uploads a live photo to the Onfido API
+func (c *Client) UploadLivePhoto(ctx context.Context, payload UploadLivePhotoPayload) (*LivePhoto, error) {
+	var livePhoto LivePhoto
+
+	req := func() error {
+		body, err := c.buildMultipart(payload)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.Post(ctx, "/live_photos", body, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &livePhoto)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &livePhoto, nil
+}
+
+// RetrieveLivePhoto retrieves a live photo from the Onfido API
+func (c *Client) RetrieveLivePhoto(ctx context.Context, livePhotoId string) (*LivePhoto, error) {
+	if livePhotoId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var livePhoto LivePhoto
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/live_photos/"+livePhotoId, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &livePhoto)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &livePhoto, nil
+}
+
+// ListLivePhotos retrieves the live photos of an applicant from the Onfido API
+func (c *Client) ListLivePhotos(ctx context.Context, applicantId string, opts ...IsListLivePhotoOption) ([]LivePhoto, *PageDetails, error) {
+	if applicantId == "" {
+		return nil, nil, ErrInvalidId
+	}
+
+	var livePhotos []LivePhoto
+	var pageDetails PageDetails
+
+	req := func() error {
+		params := c.getListLivePhotoParams(applicantId, opts...)
+
+		resp, err := c.client.Get(ctx, "/live_photos", c.getHttpRequestOptions(ctx, params, nil)...)
+		if err != nil {
+			return err
+		}
+
+		var list struct {
+			LivePhotos []LivePhoto `json:"live_photos"`
+		}
+		if err := c.getResponseOrError(resp, &list); err != nil {
+			return err
+		}
+
+		livePhotos = list.LivePhotos
+		pageDetails = c.extractPageDetails(resp.Headers)
+		return c.checkPageRange(params, len(livePhotos), pageDetails)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, nil, err
+	}
+
+	return livePhotos, &pageDetails, nil
+}
+
+// DownloadLivePhoto downloads the image of a live photo from the Onfido API
+func (c *Client) DownloadLivePhoto(ctx context.Context, livePhotoId string) (*Download, error) {
+	if livePhotoId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var livePhoto *Download
+
+	req := func() error {
+		data, err := c.download(ctx, "/live_photos/"+livePhotoId+"/download")
+		if err != nil {
+			return err
+		}
+
+		if len(data.Data) == 0 {
+			return fmt.Errorf("unable to download live photo")
+		}
+
+		livePhoto = data
+
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return livePhoto, nil
+}
+
+// DownloadLivePhotoStream downloads the image of a live photo from the Onfido API without buffering it, the caller must close the stream
+func (c *Client) DownloadLivePhotoStream(ctx context.Context, livePhotoId string) (*DownloadStream, error) {
+	if livePhotoId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var stream *DownloadStream
+
+	req := func() error {
+		body, err := c.downloadStream(ctx, "/live_photos/"+livePhotoId+"/download")
+		if err != nil {
+			return err
+		}
+
+		stream = body
+
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// DownloadLivePhotoTo writes the image of a live photo to w and returns the number of bytes written.
+//   - The download is not retried once writing to w has started
+func (c *Client) DownloadLivePhotoTo(ctx context.Context, livePhotoId string, w io.Writer) (int64, error) {
+	stream, err := c.DownloadLivePhotoStream(ctx, livePhotoId)
+	if err != nil {
+		return 0, err
+	}
+
+	return stream.copyTo(w)
+}
+
+func (c Client) getListLivePhotoParams(applicantId string, opts ...IsListLivePhotoOption) (params map[string]string) {
+	pg, lm := paginationOption{}, limitPaginationOption{}
+
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case PaginationOption:
+			opt(&pg)
+		case LimitPaginationOption:
+			opt(&lm)
+		}
+	}
+
+	params = c.getPaginationOptions(pg, lm)
+	params["applicant_id"] = applicantId
+
+	return
+}
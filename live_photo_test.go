@@ -0,0 +1,212 @@
+package onfido_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLivePhoto(t *testing.T) {
+	run := setupTestRun(t)
+	defer run.teardown()
+
+	applicant, err := run.client.CreateApplicant(run.ctx, onfido.CreateApplicantPayload{
+		FirstName: "John",
+		LastName:  "LivePhotoTest",
+	})
+	if err != nil {
+		t.Fatalf("error creating applicant: %v", err)
+	}
+
+	file, err := os.Open("./test/medias/face.png")
+	if err != nil {
+		t.Fatalf("error reading file: %v", err)
+	}
+	defer file.Close()
+
+	testLivePhoto := &onfido.LivePhoto{}
+
+	t.Run("UploadLivePhoto", testUploadLivePhoto(run, applicant.ID, file, testLivePhoto))
+	if testLivePhoto.ID == "" {
+		t.Fatalf("live photo ID is empty")
+	}
+	t.Run("RetrieveLivePhoto", testRetrieveLivePhoto(run, testLivePhoto.ID))
+	t.Run("ListLivePhotos", testListLivePhotos(run, applicant.ID, testLivePhoto.ID))
+	t.Run("DownloadLivePhoto", testDownloadLivePhoto(run, testLivePhoto.ID))
+}
+
+func testUploadLivePhoto(run *testRun, applicantID string, file *os.File, setLivePhoto *onfido.LivePhoto) func(*testing.T) {
+	advancedValidation := false
+	tests := []testCase[onfido.UploadLivePhotoPayload]{
+		{
+			name: "UploadWithoutErrors",
+			input: onfido.UploadLivePhotoPayload{
+				ApplicantID:        applicantID,
+				File:               file,
+				AdvancedValidation: &advancedValidation,
+			},
+		},
+		{
+			name: "ReturnErrorOnMissingApplicant",
+			input: onfido.UploadLivePhotoPayload{
+				File: file,
+			},
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				livePhoto, err := run.client.UploadLivePhoto(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+				if err != nil {
+					t.Fatalf("error uploading live photo: %v", err)
+				}
+
+				// Set the live photo for later tests
+				*setLivePhoto = *livePhoto
+
+				assert.NotEmpty(t, livePhoto.ID, "expected live photo ID to be set")
+				assert.NotEmpty(t, livePhoto.Href, "expected live photo href to be set")
+				assert.NotEmpty(t, livePhoto.DownloadHref, "expected live photo download href to be set")
+			})
+		}
+	}
+}
+
+func testRetrieveLivePhoto(run *testRun, livePhotoId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "RetrieveWithoutErrors",
+			input: livePhotoId,
+		},
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				livePhoto, err := run.client.RetrieveLivePhoto(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotNil(t, livePhoto, "expected live photo to be fetched")
+				assert.Equal(t, tt.input, livePhoto.ID, "expected live photo ID to match")
+				assert.NotEmpty(t, livePhoto.FileType, "expected file type to be set")
+				assert.NotZero(t, livePhoto.FileSize, "expected file size to be set")
+			})
+		}
+	}
+}
+
+func testListLivePhotos(run *testRun, applicantId, livePhotoId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "ListWithoutErrors",
+			input: applicantId,
+		},
+		{
+			name:    "ReturnErrorOnEmptyApplicantID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				livePhotos, page, err := run.client.ListLivePhotos(run.ctx, tt.input, onfido.WithPage(1), onfido.WithPageLimit(10))
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotNil(t, page, "expected page details to be fetched")
+
+				found := false
+				for _, livePhoto := range livePhotos {
+					if livePhoto.ID == livePhotoId {
+						found = true
+					}
+				}
+				assert.True(t, found, "expected uploaded live photo to be listed")
+			})
+		}
+	}
+}
+
+func testDownloadLivePhoto(run *testRun, livePhotoId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "DownloadWithoutErrors",
+			input: livePhotoId,
+		},
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				livePhoto, err := run.client.DownloadLivePhoto(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				if !assert.NotNil(t, livePhoto, "expected live photo content to be downloaded") {
+					return
+				}
+				assert.NotEmpty(t, livePhoto.Data, "expected live photo content to not be empty")
+
+				if os.Getenv("SAVE_FILES") == "true" {
+					now := time.Now().Unix()
+					saveFile(t, livePhoto.Data, fmt.Sprintf("live-photo-%s-%d.png", tt.input, now))
+				}
+			})
+		}
+	}
+}
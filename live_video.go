@@ -0,0 +1,203 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ------------------------------------------------------------------
+//                              LIVE VIDEO
+// ------------------------------------------------------------------
+
+// LiveVideo represents a live video of an applicant captured by the Onfido SDKs
+type LiveVideo struct {
+	ID           string     `json:"id,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	Href         string     `json:"href,omitempty"`
+	DownloadHref string     `json:"download_href,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	FileType     string     `json:"file_type,omitempty"`
+	FileSize     int        `json:"file_size,omitempty"`
+	Raw          RawJSON    `json:"-"`
+}
+
+func (l *LiveVideo) UnmarshalJSON(data []byte) error {
+	type liveVideo LiveVideo
+	if err := json.Unmarshal(data, (*liveVideo)(l)); err != nil {
+		return err
+	}
+
+	l.Raw = newRawJSON(data)
+	return nil
+}
+
+// ------------------------------------------------------------------
+//                              OPTIONS
+// ------------------------------------------------------------------
+
+type IsListLiveVideoOption interface {
+	isListLiveVideoOption()
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// RetrieveLiveVideo retrieves a live video from the Onfido API
+func (c *Client) RetrieveLiveVideo(ctx context.Context, liveVideoId string) (*LiveVideo, error) {
+	if liveVideoId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var liveVideo LiveVideo
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/live_videos/"+liveVideoId, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &liveVideo)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &liveVideo, nil
+}
+
+// ListLiveVideos retrieves the live videos of an applicant from the Onfido API
+func (c *Client) ListLiveVideos(ctx context.Context, applicantId string, opts ...IsListLiveVideoOption) ([]LiveVideo, *PageDetails, error) {
+	if applicantId == "" {
+		return nil, nil, ErrInvalidId
+	}
+
+	var liveVideos []LiveVideo
+	var pageDetails PageDetails
+
+	req := func() error {
+		params := c.getListLiveVideoParams(applicantId, opts...)
+
+		resp, err := c.client.Get(ctx, "/live_videos", c.getHttpRequestOptions(ctx, params, nil)...)
+		if err != nil {
+			return err
+		}
+
+		var list struct {
+			LiveVideos []LiveVideo `json:"live_videos"`
+		}
+		if err := c.getResponseOrError(resp, &list); err != nil {
+			return err
+		}
+
+		liveVideos = list.LiveVideos
+		pageDetails = c.extractPageDetails(resp.Headers)
+		return c.checkPageRange(params, len(liveVideos), pageDetails)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, nil, err
+	}
+
+	return liveVideos, &pageDetails, nil
+}
+
+// DownloadLiveVideo downloads the video of a live video from the Onfido API without buffering it,
+// the caller must close the stream.
+//
+// Deprecated: use [Client.DownloadLiveVideoStream], or [Client.DownloadLiveVideoTo] to write the video to a file.
+func (c *Client) DownloadLiveVideo(ctx context.Context, liveVideoId string) (*DownloadStream, error) {
+	return c.DownloadLiveVideoStream(ctx, liveVideoId)
+}
+
+// DownloadLiveVideoStream downloads the video of a live video from the Onfido API without buffering it, the caller must close the stream.
+//
+// Live videos are large, so there is no buffered download of them. The download is resumed from the
+// last byte received when the connection drops, see [WithDownloadChunkSize].
+func (c *Client) DownloadLiveVideoStream(ctx context.Context, liveVideoId string) (*DownloadStream, error) {
+	if liveVideoId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var stream *DownloadStream
+
+	req := func() error {
+		body, err := c.resumableDownloadStream(ctx, "/live_videos/"+liveVideoId+"/download")
+		if err != nil {
+			return err
+		}
+
+		stream = body
+
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// DownloadLiveVideoTo writes the video of a live video to w and returns the number of bytes written.
+//   - The download is not retried once writing to w has started
+func (c *Client) DownloadLiveVideoTo(ctx context.Context, liveVideoId string, w io.Writer) (int64, error) {
+	stream, err := c.DownloadLiveVideoStream(ctx, liveVideoId)
+	if err != nil {
+		return 0, err
+	}
+
+	return stream.copyTo(w)
+}
+
+// DownloadLiveVideoFrame downloads a representative still frame of a live video from the Onfido API
+func (c *Client) DownloadLiveVideoFrame(ctx context.Context, liveVideoId string) (*Download, error) {
+	if liveVideoId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var frame *Download
+
+	req := func() error {
+		data, err := c.download(ctx, "/live_videos/"+liveVideoId+"/frame")
+		if err != nil {
+			return err
+		}
+
+		if len(data.Data) == 0 {
+			return fmt.Errorf("unable to download live video frame")
+		}
+
+		frame = data
+
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return frame, nil
+}
+
+func (c Client) getListLiveVideoParams(applicantId string, opts ...IsListLiveVideoOption) (params map[string]string) {
+	pg, lm := paginationOption{}, limitPaginationOption{}
+
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case PaginationOption:
+			opt(&pg)
+		case LimitPaginationOption:
+			opt(&lm)
+		}
+	}
+
+	params = c.getPaginationOptions(pg, lm)
+	params["applicant_id"] = applicantId
+
+	return
+}
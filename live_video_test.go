@@ -0,0 +1,143 @@
+package onfido_test
+
+import (
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+// Live videos can only be captured by the Onfido SDKs, so only the error paths are exercised here
+func TestLiveVideo(t *testing.T) {
+	run := setupTestRun(t)
+	defer run.teardown()
+
+	applicant, err := run.client.CreateApplicant(run.ctx, onfido.CreateApplicantPayload{
+		FirstName: "John",
+		LastName:  "LiveVideoTest",
+	})
+	if err != nil {
+		t.Fatalf("error creating applicant: %v", err)
+	}
+
+	t.Run("RetrieveLiveVideo", testRetrieveLiveVideo(run))
+	t.Run("ListLiveVideos", testListLiveVideos(run, applicant.ID))
+	t.Run("DownloadLiveVideoStream", testDownloadLiveVideoStream(run))
+	t.Run("DownloadLiveVideoFrame", testDownloadLiveVideoFrame(run))
+}
+
+func testRetrieveLiveVideo(run *testRun) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				liveVideo, err := run.client.RetrieveLiveVideo(run.ctx, tt.input)
+				assert.Errorf(t, err, expectedError, tt.name, err)
+				assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+				assert.Nil(t, liveVideo, "expected no live video to be fetched")
+			})
+		}
+	}
+}
+
+func testListLiveVideos(run *testRun, applicantId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "ListWithoutErrors",
+			input: applicantId,
+		},
+		{
+			name:    "ReturnErrorOnEmptyApplicantID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				liveVideos, page, err := run.client.ListLiveVideos(run.ctx, tt.input, onfido.WithPage(1), onfido.WithPageLimit(10))
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotNil(t, page, "expected page details to be fetched")
+				assert.Empty(t, liveVideos, "expected no live videos for a new applicant")
+			})
+		}
+	}
+}
+
+func testDownloadLiveVideoStream(run *testRun) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				video, err := run.client.DownloadLiveVideoStream(run.ctx, tt.input)
+				assert.Errorf(t, err, expectedError, tt.name, err)
+				assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+				assert.Nil(t, video, "expected no video to be streamed")
+			})
+		}
+	}
+}
+
+func testDownloadLiveVideoFrame(run *testRun) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				frame, err := run.client.DownloadLiveVideoFrame(run.ctx, tt.input)
+				assert.Errorf(t, err, expectedError, tt.name, err)
+				assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+				assert.Nil(t, frame, "expected no frame to be downloaded")
+			})
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package onfido
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ------------------------------------------------------------------
+//                         APPLICANT MEDIA
+// ------------------------------------------------------------------
+
+// defaultMediaDownloadConcurrency is the number of downloads run in parallel when none is configured
+const defaultMediaDownloadConcurrency = 4
+
+// Storage receives the media downloaded by [Client.DownloadApplicantMedia].
+//
+// Store is called concurrently, once per media, and must read content to the end before returning.
+type Storage interface {
+	Store(ctx context.Context, name string, content io.Reader) error
+}
+
+// DirStorage is a [Storage] writing every media to a file of the directory, creating sub-directories as needed
+type DirStorage string
+
+func (d DirStorage) Store(ctx context.Context, name string, content io.Reader) (err error) {
+	fullPath := filepath.Join(string(d), filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", name, err)
+	}
+
+	file, err := os.Create(fullPath)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", name, err)
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("failed to close %s: %w", name, closeErr)
+		}
+	}()
+
+	if _, err := io.Copy(file, content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// MediaDownloadResult is the outcome of the download of a single media
+type MediaDownloadResult struct {
+	// Kind is the kind of media: documents, live_photos, live_videos or motion_captures
+	Kind string
+	ID   string
+	// Name is the name the media is stored under, e.g. documents/{id}-{file_name}
+	Name string
+	// Size is the number of bytes stored
+	Size int64
+	Err  error
+}
+
+// MediaDownloadError is returned when some media of an applicant failed to download
+type MediaDownloadError struct {
+	Failed []MediaDownloadResult
+}
+
+func (e MediaDownloadError) Error() string {
+	msgs := make([]string, len(e.Failed))
+	for i, result := range e.Failed {
+		msgs[i] = fmt.Sprintf("%s: %v", result.Name, result.Err)
+	}
+	return fmt.Sprintf("%d media downloads failed: %s", len(e.Failed), strings.Join(msgs, "; "))
+}
+
+func (e MediaDownloadError) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, result := range e.Failed {
+		errs[i] = result.Err
+	}
+	return errs
+}
+
+// mediaDownload is a media of an applicant to download
+type mediaDownload struct {
+	kind      string
+	id        string
+	name      string
+	path      string
+	resumable bool
+}
+
+// ------------------------------------------------------------------
+//                              OPTIONS
+// ------------------------------------------------------------------
+
+type mediaDownloadOptions struct {
+	concurrency int
+}
+
+type MediaDownloadOption func(*mediaDownloadOptions)
+
+// WithMediaDownloadConcurrency sets the maximum number of media downloaded in parallel
+func WithMediaDownloadConcurrency(concurrency int) MediaDownloadOption {
+	return func(o *mediaDownloadOptions) {
+		if concurrency > 0 {
+			o.concurrency = concurrency
+		}
+	}
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// DownloadApplicantMedia downloads the documents, live photos, live videos and motion captures of an
+// applicant into dest, with bounded parallelism, and returns the result of every media.
+//
+// Media are stored under the same names as in [Client.DownloadWorkflowRunEvidence]:
+//
+//	documents/{id}-{file_name}
+//	live_photos/{id}-{file_name}
+//	live_videos/{id}-{file_name}
+//	motion_captures/{id}-{file_name}
+//
+// Nothing is downloaded when listing the media fails. When some downloads fail, the results of all
+// the media are returned along with a [MediaDownloadError] describing the failures.
+func (c *Client) DownloadApplicantMedia(ctx context.Context, applicantID string, dest Storage, opts ...MediaDownloadOption) ([]MediaDownloadResult, error) {
+	if applicantID == "" {
+		return nil, ErrInvalidId
+	}
+
+	options := mediaDownloadOptions{concurrency: defaultMediaDownloadConcurrency}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	media, err := c.listApplicantMedia(ctx, applicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]MediaDownloadResult, len(media))
+
+	var group errgroup.Group
+	group.SetLimit(options.concurrency)
+
+	for i, m := range media {
+		group.Go(func() error {
+			// failures are collected in the results rather than cancelling the other downloads
+			results[i] = c.downloadMedia(ctx, dest, m)
+			return nil
+		})
+	}
+	group.Wait()
+
+	var failed []MediaDownloadResult
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	if len(failed) > 0 {
+		return results, &MediaDownloadError{Failed: failed}
+	}
+
+	return results, nil
+}
+
+func (c *Client) listApplicantMedia(ctx context.Context, applicantID string) ([]mediaDownload, error) {
+	var media []mediaDownload
+
+	documents, _, err := c.ListDocuments(ctx, applicantID)
+	if err != nil {
+		return nil, err
+	}
+	for _, document := range documents {
+		media = append(media, newMediaDownload("documents", document.ID, document.FileName, "/documents/"+document.ID+"/download", false))
+	}
+
+	livePhotos := NewScanner(func(ctx context.Context, page int) ([]LivePhoto, *PageDetails, error) {
+		return c.ListLivePhotos(ctx, applicantID, WithPage(page))
+	})
+	for livePhotos.Scan(ctx) {
+		livePhoto := livePhotos.Item()
+		media = append(media, newMediaDownload("live_photos", livePhoto.ID, livePhoto.FileName, "/live_photos/"+livePhoto.ID+"/download", false))
+	}
+	if err := livePhotos.Err(); err != nil {
+		return nil, err
+	}
+
+	liveVideos := NewScanner(func(ctx context.Context, page int) ([]LiveVideo, *PageDetails, error) {
+		return c.ListLiveVideos(ctx, applicantID, WithPage(page))
+	})
+	for liveVideos.Scan(ctx) {
+		liveVideo := liveVideos.Item()
+		media = append(media, newMediaDownload("live_videos", liveVideo.ID, liveVideo.FileName, "/live_videos/"+liveVideo.ID+"/download", true))
+	}
+	if err := liveVideos.Err(); err != nil {
+		return nil, err
+	}
+
+	motionCaptures := NewScanner(func(ctx context.Context, page int) ([]MotionCapture, *PageDetails, error) {
+		return c.ListMotionCaptures(ctx, applicantID, WithPage(page))
+	})
+	for motionCaptures.Scan(ctx) {
+		motionCapture := motionCaptures.Item()
+		media = append(media, newMediaDownload("motion_captures", motionCapture.ID, motionCapture.FileName, "/motion_captures/"+motionCapture.ID+"/download", false))
+	}
+	if err := motionCaptures.Err(); err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+func newMediaDownload(kind, id, fileName, downloadPath string, resumable bool) mediaDownload {
+	return mediaDownload{kind: kind, id: id, name: mediaName(kind, id, fileName), path: downloadPath, resumable: resumable}
+}
+
+// mediaName is the name a media is stored under, {dir}/{id}-{file_name}
+//   - The file name comes from the API, any directory of it is dropped, whatever its separators
+func mediaName(dir, id, fileName string) string {
+	name := id
+	if fileName != "" {
+		name += "-" + filepath.Base(strings.ReplaceAll(fileName, "\\", "/"))
+	}
+	return dir + "/" + name
+}
+
+func (c *Client) downloadMedia(ctx context.Context, dest Storage, m mediaDownload) MediaDownloadResult {
+	result := MediaDownloadResult{Kind: m.kind, ID: m.id, Name: m.name}
+
+	var stream *DownloadStream
+	if m.resumable {
+		stream, result.Err = c.resumableDownloadStream(ctx, m.path)
+	} else {
+		stream, result.Err = c.downloadStream(ctx, m.path)
+	}
+	if result.Err != nil {
+		return result
+	}
+	defer stream.Close()
+
+	content := &countingReader{r: stream}
+	result.Err = dest.Store(ctx, m.name, content)
+	result.Size = content.n
+
+	return result
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,162 @@
+package onfido_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadApplicantMedia(t *testing.T) {
+	lists := map[string]string{
+		"/v3.6/documents":       `{"documents":[{"id":"document-1","file_name":"passport.png"},{"id":"document-2","file_name":"licence.png"}]}`,
+		"/v3.6/live_photos":     `{"live_photos":[{"id":"live-photo-1","file_name":"selfie.jpg"}]}`,
+		"/v3.6/live_videos":     `{"live_videos":[{"id":"live-video-1","file_name":"video.mp4"}]}`,
+		"/v3.6/motion_captures": `{"motion_captures":[]}`,
+	}
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		header := http.Header{"Content-Type": {"application/json"}}
+		status, body := http.StatusOK, lists[req.URL.Path]
+		switch {
+		case req.URL.Path == "/v3.6/documents/document-2/download":
+			status, body = http.StatusNotFound, `{"error":{"type":"resource_not_found","message":"The requested resource was not found"}}`
+		case strings.HasSuffix(req.URL.Path, "/download"):
+			header.Set("Content-Type", "application/octet-stream")
+			body = "content of " + req.URL.Path
+		}
+		return &http.Response{StatusCode: status, Header: header, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	t.Run("StoreEveryMediaAndReportFailures", func(t *testing.T) {
+		storage := &memoryStorage{files: map[string]string{}}
+		results, err := client.DownloadApplicantMedia(context.Background(), "applicant-1", storage, onfido.WithMediaDownloadConcurrency(2))
+
+		var downloadErr *onfido.MediaDownloadError
+		if assert.ErrorAsf(t, err, &downloadErr, expectedError, "DownloadApplicantMedia", err) {
+			if assert.Len(t, downloadErr.Failed, 1, "expected a single failed download") {
+				assert.Equal(t, "documents/document-2-licence.png", downloadErr.Failed[0].Name)
+			}
+		}
+		assert.ErrorIsf(t, err, onfido.ErrNotFound, expectedError, "DownloadApplicantMedia", err)
+
+		assert.Len(t, results, 4, "expected a result per media")
+		assert.Equal(t, map[string]string{
+			"documents/document-1-passport.png":   "content of /v3.6/documents/document-1/download",
+			"live_photos/live-photo-1-selfie.jpg": "content of /v3.6/live_photos/live-photo-1/download",
+			"live_videos/live-video-1-video.mp4":  "content of /v3.6/live_videos/live-video-1/download",
+		}, storage.files)
+
+		for _, result := range results {
+			if result.Err == nil {
+				assert.Equal(t, int64(len(storage.files[result.Name])), result.Size, "expected the size of %s", result.Name)
+			}
+		}
+	})
+
+	t.Run("StoreToDirectory", func(t *testing.T) {
+		dir := t.TempDir()
+		_, err := client.DownloadApplicantMedia(context.Background(), "applicant-1", onfido.DirStorage(dir))
+		assert.Errorf(t, err, expectedError, "DownloadApplicantMedia", err)
+
+		content, err := os.ReadFile(filepath.Join(dir, "live_videos", "live-video-1-video.mp4"))
+		assert.NoErrorf(t, err, expectedNoError, "ReadFile", err)
+		assert.Equal(t, "content of /v3.6/live_videos/live-video-1/download", string(content))
+	})
+
+	t.Run("ReturnStorageErrors", func(t *testing.T) {
+		storage := &memoryStorage{files: map[string]string{}, err: errors.New("bucket unavailable")}
+		_, err := client.DownloadApplicantMedia(context.Background(), "applicant-1", storage)
+		assert.ErrorIsf(t, err, storage.err, expectedError, "DownloadApplicantMedia", err)
+	})
+
+	t.Run("KeepFileNamesInsideDirectory", func(t *testing.T) {
+		transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			body := `{"documents":[],"live_photos":[],"live_videos":[]}`
+			switch {
+			case req.URL.Path == "/v3.6/motion_captures":
+				body = `{"motion_captures":[{"id":"motion-1","file_name":"..\\..\\escape.mp4"},{"id":"motion-2","file_name":"../../escape.mp4"}]}`
+			case strings.HasSuffix(req.URL.Path, "/download"):
+				body = "motion"
+			}
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+		})
+		client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		storage := &memoryStorage{files: map[string]string{}}
+		_, err = client.DownloadApplicantMedia(context.Background(), "applicant-1", storage)
+		assert.NoErrorf(t, err, expectedNoError, "DownloadApplicantMedia", err)
+		assert.Equal(t, map[string]string{
+			"motion_captures/motion-1-escape.mp4": "motion",
+			"motion_captures/motion-2-escape.mp4": "motion",
+		}, storage.files)
+	})
+
+	t.Run("ReportRedirectedDownloads", func(t *testing.T) {
+		transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/download") {
+				header := http.Header{"Location": {"https://storage.example.com" + req.URL.Path}}
+				return &http.Response{StatusCode: http.StatusFound, Header: header, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+			}
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(lists[req.URL.Path])), Request: req}, nil
+		})
+		client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.DownloadApplicantMedia(context.Background(), "applicant-1", &memoryStorage{files: map[string]string{}})
+
+		var downloadErr *onfido.MediaDownloadError
+		if assert.ErrorAsf(t, err, &downloadErr, expectedError, "DownloadApplicantMedia", err) {
+			assert.Len(t, downloadErr.Failed, 4, "expected every download to fail")
+		}
+	})
+
+	t.Run("ReturnErrorOnEmptyID", func(t *testing.T) {
+		_, err := client.DownloadApplicantMedia(context.Background(), "", &memoryStorage{})
+		assert.ErrorIsf(t, err, onfido.ErrInvalidId, expectedError, "DownloadApplicantMedia", err)
+	})
+}
+
+// memoryStorage stores media in memory, failing with err when set
+type memoryStorage struct {
+	mu    sync.Mutex
+	files map[string]string
+	err   error
+}
+
+func (s *memoryStorage) Store(ctx context.Context, name string, content io.Reader) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[name] = string(data)
+	return nil
+}
@@ -0,0 +1,111 @@
+package onfido
+
+import (
+	"context"
+	"time"
+
+	"github.com/besafe-labs/onfido-go-sdk/internal/httpclient"
+)
+
+// ------------------------------------------------------------------
+//                          REQUEST METADATA
+// ------------------------------------------------------------------
+
+// RequestMetadata attributes the requests made with a context, e.g. to a tenant or an actor.
+//
+// It is never sent to Onfido, it is handed to the logger and the request hooks of the client.
+type RequestMetadata struct {
+	TenantID string
+	Actor    string
+	TraceID  string
+	// Attributes holds any additional metadata
+	Attributes map[string]string
+}
+
+type requestMetadataKey struct{}
+
+// ContextWithRequestMetadata returns a copy of ctx carrying the request metadata
+func ContextWithRequestMetadata(ctx context.Context, metadata RequestMetadata) context.Context {
+	return context.WithValue(ctx, requestMetadataKey{}, metadata)
+}
+
+// RequestMetadataFromContext returns the request metadata carried by ctx, if any
+func RequestMetadataFromContext(ctx context.Context) (RequestMetadata, bool) {
+	metadata, ok := ctx.Value(requestMetadataKey{}).(RequestMetadata)
+	return metadata, ok
+}
+
+// logArgs returns the metadata as key-value pairs for the logger
+func (m RequestMetadata) logArgs() []any {
+	var args []any
+	if m.TenantID != "" {
+		args = append(args, "tenant_id", m.TenantID)
+	}
+	if m.Actor != "" {
+		args = append(args, "actor", m.Actor)
+	}
+	if m.TraceID != "" {
+		args = append(args, "trace_id", m.TraceID)
+	}
+	for k, v := range m.Attributes {
+		args = append(args, k, v)
+	}
+	return args
+}
+
+func requestMetadataLogArgs(ctx context.Context) []any {
+	metadata, ok := RequestMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return metadata.logArgs()
+}
+
+// ------------------------------------------------------------------
+//                              HOOKS
+// ------------------------------------------------------------------
+
+// RequestInfo describes a request made by the client, once it has completed
+type RequestInfo struct {
+	Method string
+	Path   string
+	// Endpoint is the method and path template of the request, e.g. "GET /applicants/{id}"
+	Endpoint   string
+	StatusCode int
+	// RequestID is the identifier given by Onfido to the request, to quote in support tickets
+	RequestID string
+	Duration  time.Duration
+	// Attempts is the number of times the request was sent, retries included
+	Attempts int
+	Err      error
+	// Metadata is the request metadata carried by the context of the request
+	Metadata RequestMetadata
+}
+
+// RequestHook is called once a request has completed, successfully or not
+type RequestHook func(ctx context.Context, info RequestInfo)
+
+// WithRequestHook registers a hook called after every request, e.g. for auditing.
+// Hooks are called synchronously and should return quickly.
+func WithRequestHook(hook RequestHook) ClientOption {
+	return func(c *clientOptions) {
+		c.requestHooks = append(c.requestHooks, hook)
+	}
+}
+
+func (hook RequestHook) httpHook() httpclient.RequestHook {
+	return func(ctx context.Context, info httpclient.RequestInfo) {
+		metadata, _ := RequestMetadataFromContext(ctx)
+		hook(ctx, RequestInfo{
+			Method:     info.Method,
+			Path:       info.Path,
+			Endpoint:   info.Method + " " + endpointTemplate(info.Path),
+			StatusCode: info.StatusCode,
+			RequestID:  info.RequestID,
+			Duration:   info.Duration,
+			Attempts:   info.Attempts,
+			Err:        info.Err,
+			Metadata:   metadata,
+		})
+	}
+}
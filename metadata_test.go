@@ -0,0 +1,81 @@
+package onfido_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestMetadata(t *testing.T) {
+	t.Run("RoundTripThroughContext", func(t *testing.T) {
+		metadata := onfido.RequestMetadata{
+			TenantID:   "tenant-1",
+			Actor:      "reviewer@example.com",
+			TraceID:    "trace-1",
+			Attributes: map[string]string{"job": "nightly-sync"},
+		}
+
+		ctx := onfido.ContextWithRequestMetadata(context.Background(), metadata)
+		got, ok := onfido.RequestMetadataFromContext(ctx)
+		assert.True(t, ok, "expected metadata to be found")
+		assert.Equal(t, metadata, got, "expected metadata to match")
+	})
+
+	t.Run("MissingFromContext", func(t *testing.T) {
+		_, ok := onfido.RequestMetadataFromContext(context.Background())
+		assert.False(t, ok, "expected no metadata to be found")
+	})
+
+	t.Run("HandToHooksAndLogger", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"applicant-1"}`))
+		}))
+		defer server.Close()
+
+		var infos []onfido.RequestInfo
+		var logs bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+		client, teardown, err := setupClient("token",
+			onfido.WithBaseURL(server.URL+"/"),
+			onfido.WithLogger(logger),
+			onfido.WithRequestHook(func(ctx context.Context, info onfido.RequestInfo) {
+				infos = append(infos, info)
+			}))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		metadata := onfido.RequestMetadata{
+			TenantID:   "tenant-1",
+			Actor:      "reviewer@example.com",
+			TraceID:    "trace-1",
+			Attributes: map[string]string{"job": "nightly-sync"},
+		}
+		ctx := onfido.ContextWithRequestMetadata(context.Background(), metadata)
+
+		_, err = client.RetrieveApplicant(ctx, "applicant-1")
+		assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+
+		if assert.Len(t, infos, 1, "expected the hook to be called once") {
+			assert.Equal(t, metadata, infos[0].Metadata, "expected the hook to receive the metadata")
+		}
+
+		var record map[string]any
+		if assert.NoError(t, json.Unmarshal(logs.Bytes(), &record), "expected a single log record") {
+			assert.Equal(t, "tenant-1", record["tenant_id"])
+			assert.Equal(t, "reviewer@example.com", record["actor"])
+			assert.Equal(t, "trace-1", record["trace_id"])
+			assert.Equal(t, "nightly-sync", record["job"])
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package onfido
+
+import (
+	"context"
+	"time"
+
+	"github.com/besafe-labs/onfido-go-sdk/internal/httpclient"
+)
+
+// ------------------------------------------------------------------
+//                              METRICS
+// ------------------------------------------------------------------
+
+// MetricsCollector records the requests made by the client, e.g. to export error rates and
+// latencies to a monitoring system. The onfidoprom module provides a Prometheus collector.
+//
+// endpoint is the path template of the request, e.g. "/applicants/{id}", so that the cardinality
+// of the recorded series stays bounded. status is 0 when the request failed without a response,
+// and retries is the number of attempts made after the first one.
+//
+// RecordRequest is called synchronously after every request and should return quickly.
+type MetricsCollector interface {
+	RecordRequest(endpoint, method string, status int, duration time.Duration, retries int)
+}
+
+// WithMetricsCollector sets the collector recording the requests made by the client
+func WithMetricsCollector(collector MetricsCollector) ClientOption {
+	return func(c *clientOptions) {
+		c.metrics = collector
+	}
+}
+
+func metricsHook(collector MetricsCollector) httpclient.RequestHook {
+	return func(_ context.Context, info httpclient.RequestInfo) {
+		retries := info.Attempts - 1
+		if retries < 0 {
+			retries = 0
+		}
+		collector.RecordRequest(endpointTemplate(info.Path), info.Method, info.StatusCode, info.Duration, retries)
+	}
+}
@@ -0,0 +1,62 @@
+package onfido_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordedRequest struct {
+	endpoint string
+	method   string
+	status   int
+	retries  int
+}
+
+type recordingCollector struct {
+	requests []recordedRequest
+}
+
+func (c *recordingCollector) RecordRequest(endpoint, method string, status int, duration time.Duration, retries int) {
+	c.requests = append(c.requests, recordedRequest{endpoint: endpoint, method: method, status: status, retries: retries})
+}
+
+func TestMetricsCollector(t *testing.T) {
+	attempts := 0
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		status := http.StatusOK
+		if attempts == 1 {
+			status = http.StatusServiceUnavailable
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"8a9f3b2c-1d4e-4f5a-9b6c-7d8e9f0a1b2c"}`)),
+			Request:    req,
+		}, nil
+	})
+
+	collector := &recordingCollector{}
+	client, teardown, err := setupClient("token",
+		onfido.WithTransport(transport),
+		onfido.WithRetries(1, time.Millisecond),
+		onfido.WithMetricsCollector(collector),
+	)
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	_, err = client.RetrieveApplicant(context.Background(), "8a9f3b2c-1d4e-4f5a-9b6c-7d8e9f0a1b2c")
+	assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+
+	expected := []recordedRequest{{endpoint: "/applicants/{id}", method: http.MethodGet, status: http.StatusOK, retries: 1}}
+	assert.Equal(t, expected, collector.requests, "expected request to be recorded once with its retries")
+}
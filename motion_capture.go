@@ -0,0 +1,222 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ------------------------------------------------------------------
+//                            MOTION CAPTURE
+// ------------------------------------------------------------------
+
+// MotionCapture represents a Motion biometric capture of an applicant taken by the Onfido SDKs
+type MotionCapture struct {
+	ID           string     `json:"id,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	Href         string     `json:"href,omitempty"`
+	DownloadHref string     `json:"download_href,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	FileType     string     `json:"file_type,omitempty"`
+	FileSize     int        `json:"file_size,omitempty"`
+	Raw          RawJSON    `json:"-"`
+}
+
+func (m *MotionCapture) UnmarshalJSON(data []byte) error {
+	type motionCapture MotionCapture
+	if err := json.Unmarshal(data, (*motionCapture)(m)); err != nil {
+		return err
+	}
+
+	m.Raw = newRawJSON(data)
+	return nil
+}
+
+// ------------------------------------------------------------------
+//                              OPTIONS
+// ------------------------------------------------------------------
+
+type IsListMotionCaptureOption interface {
+	isListMotionCaptureOption()
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// RetrieveMotionCapture retrieves a motion capture from the Onfido API
+func (c *Client) RetrieveMotionCapture(ctx context.Context, motionCaptureId string) (*MotionCapture, error) {
+	if motionCaptureId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var motionCapture MotionCapture
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/motion_captures/"+motionCaptureId, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &motionCapture)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &motionCapture, nil
+}
+
+// ListMotionCaptures retrieves the motion captures of an applicant from the Onfido API
+func (c *Client) ListMotionCaptures(ctx context.Context, applicantId string, opts ...IsListMotionCaptureOption) ([]MotionCapture, *PageDetails, error) {
+	if applicantId == "" {
+		return nil, nil, ErrInvalidId
+	}
+
+	var motionCaptures []MotionCapture
+	var pageDetails PageDetails
+
+	req := func() error {
+		params := c.getListMotionCaptureParams(applicantId, opts...)
+
+		resp, err := c.client.Get(ctx, "/motion_captures", c.getHttpRequestOptions(ctx, params, nil)...)
+		if err != nil {
+			return err
+		}
+
+		var list struct {
+			MotionCaptures []MotionCapture `json:"motion_captures"`
+		}
+		if err := c.getResponseOrError(resp, &list); err != nil {
+			return err
+		}
+
+		motionCaptures = list.MotionCaptures
+		pageDetails = c.extractPageDetails(resp.Headers)
+		return c.checkPageRange(params, len(motionCaptures), pageDetails)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, nil, err
+	}
+
+	return motionCaptures, &pageDetails, nil
+}
+
+// DownloadMotionCapture downloads the video clip of a motion capture from the Onfido API
+func (c *Client) DownloadMotionCapture(ctx context.Context, motionCaptureId string) (*Download, error) {
+	if motionCaptureId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var clip *Download
+
+	req := func() error {
+		data, err := c.download(ctx, "/motion_captures/"+motionCaptureId+"/download")
+		if err != nil {
+			return err
+		}
+
+		if len(data.Data) == 0 {
+			return fmt.Errorf("unable to download motion capture")
+		}
+
+		clip = data
+
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return clip, nil
+}
+
+// DownloadMotionCaptureStream downloads the video clip of a motion capture from the Onfido API without buffering it, the caller must close the stream
+func (c *Client) DownloadMotionCaptureStream(ctx context.Context, motionCaptureId string) (*DownloadStream, error) {
+	if motionCaptureId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var stream *DownloadStream
+
+	req := func() error {
+		body, err := c.downloadStream(ctx, "/motion_captures/"+motionCaptureId+"/download")
+		if err != nil {
+			return err
+		}
+
+		stream = body
+
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// DownloadMotionCaptureTo writes the video clip of a motion capture to w and returns the number of bytes written.
+//   - The download is not retried once writing to w has started
+func (c *Client) DownloadMotionCaptureTo(ctx context.Context, motionCaptureId string, w io.Writer) (int64, error) {
+	stream, err := c.DownloadMotionCaptureStream(ctx, motionCaptureId)
+	if err != nil {
+		return 0, err
+	}
+
+	return stream.copyTo(w)
+}
+
+// DownloadMotionCaptureFrame downloads a still frame of a motion capture from the Onfido API
+func (c *Client) DownloadMotionCaptureFrame(ctx context.Context, motionCaptureId string) (*Download, error) {
+	if motionCaptureId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var frame *Download
+
+	req := func() error {
+		data, err := c.download(ctx, "/motion_captures/"+motionCaptureId+"/frame")
+		if err != nil {
+			return err
+		}
+
+		if len(data.Data) == 0 {
+			return fmt.Errorf("unable to download motion capture frame")
+		}
+
+		frame = data
+
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return frame, nil
+}
+
+func (c Client) getListMotionCaptureParams(applicantId string, opts ...IsListMotionCaptureOption) (params map[string]string) {
+	pg, lm := paginationOption{}, limitPaginationOption{}
+
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case PaginationOption:
+			opt(&pg)
+		case LimitPaginationOption:
+			opt(&lm)
+		}
+	}
+
+	params = c.getPaginationOptions(pg, lm)
+	params["applicant_id"] = applicantId
+
+	return
+}
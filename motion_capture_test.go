@@ -0,0 +1,143 @@
+package onfido_test
+
+import (
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+// Motion captures can only be taken by the Onfido SDKs, so only the error paths and empty lists are exercised here
+func TestMotionCapture(t *testing.T) {
+	run := setupTestRun(t)
+	defer run.teardown()
+
+	applicant, err := run.client.CreateApplicant(run.ctx, onfido.CreateApplicantPayload{
+		FirstName: "John",
+		LastName:  "MotionCaptureTest",
+	})
+	if err != nil {
+		t.Fatalf("error creating applicant: %v", err)
+	}
+
+	t.Run("RetrieveMotionCapture", testRetrieveMotionCapture(run))
+	t.Run("ListMotionCaptures", testListMotionCaptures(run, applicant.ID))
+	t.Run("DownloadMotionCapture", testDownloadMotionCapture(run))
+	t.Run("DownloadMotionCaptureFrame", testDownloadMotionCaptureFrame(run))
+}
+
+func testRetrieveMotionCapture(run *testRun) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				motionCapture, err := run.client.RetrieveMotionCapture(run.ctx, tt.input)
+				assert.Errorf(t, err, expectedError, tt.name, err)
+				assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+				assert.Nil(t, motionCapture, "expected no motion capture to be fetched")
+			})
+		}
+	}
+}
+
+func testListMotionCaptures(run *testRun, applicantId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "ListWithoutErrors",
+			input: applicantId,
+		},
+		{
+			name:    "ReturnErrorOnEmptyApplicantID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				motionCaptures, page, err := run.client.ListMotionCaptures(run.ctx, tt.input, onfido.WithPage(1), onfido.WithPageLimit(10))
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotNil(t, page, "expected page details to be fetched")
+				assert.Empty(t, motionCaptures, "expected no motion captures for a new applicant")
+			})
+		}
+	}
+}
+
+func testDownloadMotionCapture(run *testRun) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				clip, err := run.client.DownloadMotionCapture(run.ctx, tt.input)
+				assert.Errorf(t, err, expectedError, tt.name, err)
+				assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+				assert.Nil(t, clip, "expected no motion capture to be downloaded")
+			})
+		}
+	}
+}
+
+func testDownloadMotionCaptureFrame(run *testRun) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				frame, err := run.client.DownloadMotionCaptureFrame(run.ctx, tt.input)
+				assert.Errorf(t, err, expectedError, tt.name, err)
+				assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+				assert.Nil(t, frame, "expected no motion capture frame to be downloaded")
+			})
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package onfido
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ------------------------------------------------------------------
+//                        MULTI-REGION CLIENT
+// ------------------------------------------------------------------
+
+// ErrRegionNotConfigured is returned when routing a request to a region without a client
+var ErrRegionNotConfigured = errors.New("onfido: region not configured")
+
+// RegionToken is the API token of an Onfido region, tokens only grant access to their own region
+type RegionToken struct {
+	Region apiRegion
+	Token  string
+}
+
+// MultiRegionClient routes requests to the Onfido region holding the data, e.g. when EU and CA
+// applicants are stored in different regions, with a client per region sharing the same configuration:
+//
+//	client, err := onfido.NewMultiRegionClient([]onfido.RegionToken{
+//		{Region: onfido.API_REGION_EU, Token: euToken},
+//		{Region: onfido.API_REGION_CA, Token: caToken},
+//	}, onfido.WithRetries(3, time.Second))
+//
+//	ca, err := client.Region(onfido.API_REGION_CA)
+//	applicant, err := ca.RetrieveApplicant(ctx, applicantID)
+type MultiRegionClient struct {
+	clients map[apiRegion]*Client
+}
+
+// NewMultiRegionClient creates a client for the region of every token, configured with opts.
+//
+// The region of each client is the region of its token, options setting a region or a base URL don't apply.
+func NewMultiRegionClient(tokens []RegionToken, opts ...ClientOption) (*MultiRegionClient, error) {
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("at least one region token is required")
+	}
+
+	m := &MultiRegionClient{clients: make(map[apiRegion]*Client, len(tokens))}
+	for _, token := range tokens {
+		if _, ok := m.clients[token.Region]; ok {
+			m.Close()
+			return nil, fmt.Errorf("duplicate token for region %q", token.Region)
+		}
+
+		regionOpts := append(append([]ClientOption{}, opts...), WithRegion(token.Region), WithBaseURL(""))
+		client, err := NewClient(token.Token, regionOpts...)
+		if err != nil {
+			m.Close()
+			return nil, fmt.Errorf("failed to create client for region %q: %w", token.Region, err)
+		}
+		m.clients[token.Region] = client
+	}
+
+	return m, nil
+}
+
+// Region returns the client sending requests to region
+func (m *MultiRegionClient) Region(region apiRegion) (*Client, error) {
+	client, ok := m.clients[region]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrRegionNotConfigured, region)
+	}
+	return client, nil
+}
+
+// Close closes the idle connections of the clients of every region
+func (m *MultiRegionClient) Close() {
+	for _, client := range m.clients {
+		client.Close()
+	}
+}
@@ -0,0 +1,68 @@
+package onfido_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiRegionClient(t *testing.T) {
+	var requests []string
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.URL.Host+" "+req.Header.Get("Authorization"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"applicant-id"}`)),
+			Request:    req,
+		}, nil
+	})
+
+	client, err := onfido.NewMultiRegionClient([]onfido.RegionToken{
+		{Region: onfido.API_REGION_EU, Token: "api_live.eu"},
+		{Region: onfido.API_REGION_CA, Token: "api_live_ca.ca"},
+	}, onfido.WithTransport(transport), onfido.WithRegion(onfido.API_REGION_US), onfido.WithRegionGuard())
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer client.Close()
+
+	t.Run("RouteRequestsByRegion", func(t *testing.T) {
+		eu, err := client.Region(onfido.API_REGION_EU)
+		if !assert.NoError(t, err, "expected EU region to be configured") {
+			return
+		}
+		ca, err := client.Region(onfido.API_REGION_CA)
+		if !assert.NoError(t, err, "expected CA region to be configured") {
+			return
+		}
+
+		_, err = eu.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+		_, err = ca.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+
+		assert.Equal(t, []string{
+			"api.eu.onfido.com Token token=api_live.eu",
+			"api.ca.onfido.com Token token=api_live_ca.ca",
+		}, requests, "expected requests to be sent to the region of their client with its token")
+	})
+
+	t.Run("ReturnErrorOnUnconfiguredRegion", func(t *testing.T) {
+		_, err := client.Region(onfido.API_REGION_US)
+		assert.ErrorIs(t, err, onfido.ErrRegionNotConfigured)
+	})
+
+	t.Run("ReturnErrorOnDuplicateRegion", func(t *testing.T) {
+		_, err := onfido.NewMultiRegionClient([]onfido.RegionToken{
+			{Region: onfido.API_REGION_EU, Token: "api_live.first"},
+			{Region: onfido.API_REGION_EU, Token: "api_live.second"},
+		})
+		assert.Error(t, err, "expected an error for duplicate regions")
+	})
+}
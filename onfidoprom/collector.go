@@ -0,0 +1,71 @@
+// Package onfidoprom exports the requests made by an onfido.Client as Prometheus metrics.
+//
+//	collector, err := onfidoprom.NewCollector(prometheus.DefaultRegisterer)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client, err := onfido.NewClient(token, onfido.WithMetricsCollector(collector))
+package onfidoprom
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var _ onfido.MetricsCollector = (*Collector)(nil)
+
+// Collector records the requests of an onfido.Client as Prometheus metrics:
+//
+//   - onfido_requests_total, a counter of requests by endpoint, method and status
+//     (the status is "error" for requests that failed without a response)
+//   - onfido_request_duration_seconds, a histogram of request latencies, retries included, by endpoint and method
+//   - onfido_request_retries_total, a counter of retried attempts by endpoint and method
+type Collector struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	retries  *prometheus.CounterVec
+}
+
+// NewCollector creates a Collector and registers its metrics with reg
+func NewCollector(reg prometheus.Registerer) (*Collector, error) {
+	c := &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "onfido_requests_total",
+			Help: "Number of requests made to the Onfido API.",
+		}, []string{"endpoint", "method", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "onfido_request_duration_seconds",
+			Help:    "Latency of the requests made to the Onfido API, retries included.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"endpoint", "method"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "onfido_request_retries_total",
+			Help: "Number of retried attempts of the requests made to the Onfido API.",
+		}, []string{"endpoint", "method"}),
+	}
+
+	for _, collector := range []prometheus.Collector{c.requests, c.duration, c.retries} {
+		if err := reg.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// RecordRequest implements onfido.MetricsCollector
+func (c *Collector) RecordRequest(endpoint, method string, status int, duration time.Duration, retries int) {
+	statusLabel := "error"
+	if status != 0 {
+		statusLabel = strconv.Itoa(status)
+	}
+
+	c.requests.WithLabelValues(endpoint, method, statusLabel).Inc()
+	c.duration.WithLabelValues(endpoint, method).Observe(duration.Seconds())
+	if retries > 0 {
+		c.retries.WithLabelValues(endpoint, method).Add(float64(retries))
+	}
+}
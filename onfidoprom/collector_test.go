@@ -0,0 +1,38 @@
+package onfidoprom
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollector(t *testing.T) {
+	t.Run("RecordRequests", func(t *testing.T) {
+		collector, err := NewCollector(prometheus.NewRegistry())
+		if !assert.NoError(t, err, "expected no error") {
+			return
+		}
+
+		collector.RecordRequest("/applicants/{id}", http.MethodGet, http.StatusOK, 20*time.Millisecond, 0)
+		collector.RecordRequest("/applicants/{id}", http.MethodGet, http.StatusOK, 40*time.Millisecond, 2)
+		collector.RecordRequest("/applicants", http.MethodPost, 0, time.Second, 3)
+
+		assert.Equal(t, 2.0, testutil.ToFloat64(collector.requests.WithLabelValues("/applicants/{id}", http.MethodGet, "200")))
+		assert.Equal(t, 1.0, testutil.ToFloat64(collector.requests.WithLabelValues("/applicants", http.MethodPost, "error")))
+		assert.Equal(t, 2.0, testutil.ToFloat64(collector.retries.WithLabelValues("/applicants/{id}", http.MethodGet)))
+		assert.Equal(t, 2, testutil.CollectAndCount(collector.duration), "expected a histogram per endpoint")
+	})
+
+	t.Run("ReturnErrorOnDuplicateRegistration", func(t *testing.T) {
+		reg := prometheus.NewRegistry()
+		_, err := NewCollector(reg)
+		assert.NoError(t, err, "expected no error")
+
+		_, err = NewCollector(reg)
+		assert.Error(t, err, "expected an error registering the metrics twice")
+	})
+}
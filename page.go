@@ -0,0 +1,51 @@
+package onfido
+
+// ------------------------------------------------------------------
+//                               PAGE
+// ------------------------------------------------------------------
+
+// Page is a page of a paginated list endpoint, giving every paginated resource the same shape
+type Page[T any] struct {
+	Items   []T
+	Details PageDetails
+}
+
+// NewPage wraps the results of any paginated list method in a Page:
+//
+//	page, err := onfido.NewPage(client.ListApplicants(ctx, onfido.WithPage(2)))
+//	if err != nil {
+//		...
+//	}
+//	for _, applicant := range page.Items {
+//		...
+//	}
+//	if next, ok := page.Next(); ok {
+//		...
+//	}
+func NewPage[T any](items []T, details *PageDetails, err error) (*Page[T], error) {
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page[T]{Items: items}
+	if details != nil {
+		page.Details = *details
+	}
+	return page, nil
+}
+
+// Next returns the number of the next page, false on the last page
+func (p *Page[T]) Next() (int, bool) {
+	if p.Details.NextPage == nil {
+		return 0, false
+	}
+	return *p.Details.NextPage, true
+}
+
+// Total returns the number of items of the list across all pages, false if the API didn't report it
+func (p *Page[T]) Total() (int, bool) {
+	if p.Details.Total == nil {
+		return 0, false
+	}
+	return *p.Details.Total, true
+}
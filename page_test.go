@@ -0,0 +1,62 @@
+package onfido_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPage(t *testing.T) {
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		header := http.Header{
+			"Content-Type":  {"application/json"},
+			"X-Total-Count": {"3"},
+			"Link":          {`<https://api.eu.onfido.com/v3.6/applicants?page=2&per_page=2>; rel="next"`},
+		}
+		body := `{"applicants":[{"id":"applicant-1"},{"id":"applicant-2"}]}`
+		return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	t.Run("WrapListResults", func(t *testing.T) {
+		page, err := onfido.NewPage(client.ListApplicants(context.Background(), onfido.WithPageLimit(2)))
+		if !assert.NoErrorf(t, err, expectedNoError, "ListApplicants", err) {
+			return
+		}
+
+		assert.Len(t, page.Items, 2, "expected items of the page")
+		next, ok := page.Next()
+		assert.True(t, ok, "expected a next page")
+		assert.Equal(t, 2, next)
+		total, ok := page.Total()
+		assert.True(t, ok, "expected a total")
+		assert.Equal(t, 3, total)
+	})
+
+	t.Run("ReturnListErrors", func(t *testing.T) {
+		listErr := errors.New("list failed")
+		page, err := onfido.NewPage[onfido.Document](nil, nil, listErr)
+		assert.ErrorIs(t, err, listErr)
+		assert.Nil(t, page, "expected no page")
+	})
+
+	t.Run("ReportLastPage", func(t *testing.T) {
+		page, err := onfido.NewPage([]onfido.Document{{ID: "document-id"}}, &onfido.PageDetails{}, nil)
+		assert.NoError(t, err, "expected no error")
+		_, ok := page.Next()
+		assert.False(t, ok, "expected no next page")
+		_, ok = page.Total()
+		assert.False(t, ok, "expected no total")
+	})
+}
@@ -0,0 +1,18 @@
+package onfido
+
+import "encoding/json"
+
+// ------------------------------------------------------------------
+//                              RAW JSON
+// ------------------------------------------------------------------
+
+// RawJSON is the JSON of a resource as returned by the API, including the fields the SDK doesn't model yet.
+//
+// Resources decoded from API responses keep it in their Raw field, so that new or unmodelled fields
+// can be read without waiting for an SDK release. Raw is never encoded back to the API.
+type RawJSON = json.RawMessage
+
+// newRawJSON copies data, which the JSON decoder may reuse once UnmarshalJSON returns
+func newRawJSON(data []byte) RawJSON {
+	return append(RawJSON(nil), data...)
+}
@@ -0,0 +1,148 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ------------------------------------------------------------------
+//                              REPORT
+// ------------------------------------------------------------------
+
+// Report represents a report in the Onfido API.
+//
+// The breakdown and properties of a report depend on its name.
+type Report struct {
+	ID         string           `json:"id,omitempty"`
+	Name       ReportName       `json:"name,omitempty"`
+	Status     ReportStatus     `json:"status,omitempty"`
+	Result     ReportResult     `json:"result,omitempty"`
+	SubResult  ReportSubResult  `json:"sub_result,omitempty"`
+	CheckID    string           `json:"check_id,omitempty"`
+	Documents  []ReportDocument `json:"documents,omitempty"`
+	Breakdown  map[string]any   `json:"breakdown,omitempty"`
+	Properties map[string]any   `json:"properties,omitempty"`
+	Href       string           `json:"href,omitempty"`
+	CreatedAt  *time.Time       `json:"created_at,omitempty"`
+	Raw        RawJSON          `json:"-"`
+}
+
+func (r *Report) UnmarshalJSON(data []byte) error {
+	type report Report
+	if err := json.Unmarshal(data, (*report)(r)); err != nil {
+		return err
+	}
+
+	r.Raw = newRawJSON(data)
+	return nil
+}
+
+// ReportDocument references a document used by a report
+type ReportDocument struct {
+	ID string `json:"id,omitempty"`
+}
+
+// ReportName represents the name of a report
+//   - The report names declared here are not exhaustive, the API may support more reports
+type ReportName string
+
+const (
+	ReportNameDocument                       ReportName = "document"
+	ReportNameDocumentWithAddressInformation ReportName = "document_with_address_information"
+	ReportNameFacialSimilarityPhoto          ReportName = "facial_similarity_photo"
+	ReportNameFacialSimilarityPhotoFullyAuto ReportName = "facial_similarity_photo_fully_auto"
+	ReportNameFacialSimilarityVideo          ReportName = "facial_similarity_video"
+	ReportNameFacialSimilarityMotion         ReportName = "facial_similarity_motion"
+	ReportNameKnownFaces                     ReportName = "known_faces"
+	ReportNameIdentityEnhanced               ReportName = "identity_enhanced"
+	ReportNameWatchlistAML                   ReportName = "watchlist_aml"
+	ReportNameWatchlistEnhanced              ReportName = "watchlist_enhanced"
+	ReportNameWatchlistStandard              ReportName = "watchlist_standard"
+	ReportNameWatchlistPepsOnly              ReportName = "watchlist_peps_only"
+	ReportNameWatchlistSanctionsOnly         ReportName = "watchlist_sanctions_only"
+	ReportNameProofOfAddress                 ReportName = "proof_of_address"
+	ReportNameUSDrivingLicence               ReportName = "us_driving_licence"
+	ReportNameDeviceIntelligence             ReportName = "device_intelligence"
+)
+
+// ReportStatus represents the status of a report
+type ReportStatus string
+
+const (
+	ReportStatusAwaitingData     ReportStatus = "awaiting_data"
+	ReportStatusAwaitingApproval ReportStatus = "awaiting_approval"
+	ReportStatusComplete         ReportStatus = "complete"
+	ReportStatusWithdrawn        ReportStatus = "withdrawn"
+	ReportStatusPaused           ReportStatus = "paused"
+	ReportStatusCancelled        ReportStatus = "cancelled"
+)
+
+// ReportResult represents the result of a report
+type ReportResult string
+
+const (
+	ReportResultClear        ReportResult = "clear"
+	ReportResultConsider     ReportResult = "consider"
+	ReportResultUnidentified ReportResult = "unidentified"
+)
+
+// ReportSubResult represents the sub result of a document report
+type ReportSubResult string
+
+const (
+	ReportSubResultClear     ReportSubResult = "clear"
+	ReportSubResultRejected  ReportSubResult = "rejected"
+	ReportSubResultSuspected ReportSubResult = "suspected"
+	ReportSubResultCaution   ReportSubResult = "caution"
+)
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// RetrieveReport retrieves a report from the Onfido API
+func (c *Client) RetrieveReport(ctx context.Context, reportId string) (*Report, error) {
+	if reportId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var report Report
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/reports/"+reportId, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &report)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// ResumeReport resumes a paused report in the Onfido API
+func (c *Client) ResumeReport(ctx context.Context, reportId string) error {
+	if reportId == "" {
+		return ErrInvalidId
+	}
+
+	req := func() error {
+		resp, err := c.client.Post(ctx, "/reports/"+reportId+"/resume", nil, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, nil)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
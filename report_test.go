@@ -0,0 +1,173 @@
+package onfido_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReport(t *testing.T) {
+	run := setupTestRun(t)
+	defer run.teardown()
+
+	t.Run("RetrieveReport", testRetrieveReport(run))
+	t.Run("ResumeReport", testResumeReport(run))
+}
+
+const documentReportJSON = `{
+	"id": "6951786-123123-422221",
+	"name": "document",
+	"status": "complete",
+	"result": "consider",
+	"sub_result": "rejected",
+	"check_id": "8546921-123123-234234",
+	"documents": [{"id": "7568496-123123-123123"}],
+	"breakdown": {
+		"data_comparison": {"result": "clear", "breakdown": {"first_name": {"result": "clear", "properties": {}}}},
+		"visual_authenticity": {"result": "consider", "breakdown": {"template": {"result": "consider"}}},
+		"compromised_document": {"result": "clear"}
+	},
+	"properties": {
+		"document_type": "passport",
+		"issuing_country": "GBR",
+		"document_numbers": [{"type": "document_number", "value": "123456789"}],
+		"mrz_line1": "P<GBRDOE<<JOHN<<<<<<<<<<<<<<<<<<<<<<<<<<<<<",
+		"date_of_birth": "1990-01-01"
+	}
+}`
+
+func TestDocumentReport(t *testing.T) {
+	var report onfido.Report
+	if err := json.Unmarshal([]byte(documentReportJSON), &report); err != nil {
+		t.Fatalf("error decoding report: %v", err)
+	}
+
+	t.Run("DecodeTypedBreakdownAndProperties", func(t *testing.T) {
+		documentReport, err := report.AsDocumentReport()
+		assert.NoErrorf(t, err, expectedNoError, "AsDocumentReport", err)
+
+		assert.Equal(t, onfido.ReportSubResultRejected, documentReport.SubResult)
+		assert.Equal(t, "8546921-123123-234234", documentReport.CheckID)
+		if assert.NotNil(t, documentReport.Breakdown, "expected breakdown to be set") {
+			assert.Equal(t, "clear", documentReport.Breakdown.DataComparison.Breakdown.FirstName.Result)
+			assert.Equal(t, "consider", documentReport.Breakdown.VisualAuthenticity.Breakdown.Template.Result)
+			assert.Equal(t, "clear", documentReport.Breakdown.CompromisedDocument.Result)
+		}
+		if assert.NotNil(t, documentReport.Properties, "expected properties to be set") {
+			assert.Equal(t, onfido.DocumentTypePassport, documentReport.Properties.DocumentType)
+			assert.Equal(t, "123456789", documentReport.Properties.DocumentNumbers[0].Value)
+			assert.Equal(t, "1990-01-01", documentReport.Properties.DateOfBirth)
+		}
+	})
+
+	t.Run("ReturnErrorOnOtherReport", func(t *testing.T) {
+		_, err := (&onfido.Report{Name: onfido.ReportNameFacialSimilarityPhoto}).AsDocumentReport()
+		assert.Errorf(t, err, expectedError, "AsDocumentReport", err)
+	})
+}
+
+const facialSimilarityVideoReportJSON = `{
+	"id": "6951786-123123-422222",
+	"name": "facial_similarity_video",
+	"status": "complete",
+	"result": "clear",
+	"breakdown": {
+		"face_comparison": {"result": "clear", "breakdown": {"face_match": {"result": "clear", "properties": {"score": 0.83}}}},
+		"image_integrity": {"result": "clear", "breakdown": {"face_detected": {"result": "clear"}, "source_integrity": {"result": "clear"}}},
+		"visual_authenticity": {"result": "clear", "breakdown": {"liveness_detected": {"result": "clear"}, "spoofing_detection": {"result": "clear", "properties": {"score": 0.9}}}}
+	}
+}`
+
+func TestFacialSimilarityReport(t *testing.T) {
+	var report onfido.Report
+	if err := json.Unmarshal([]byte(facialSimilarityVideoReportJSON), &report); err != nil {
+		t.Fatalf("error decoding report: %v", err)
+	}
+
+	t.Run("DecodeTypedBreakdown", func(t *testing.T) {
+		facialReport, err := report.AsFacialSimilarityReport()
+		assert.NoErrorf(t, err, expectedNoError, "AsFacialSimilarityReport", err)
+
+		if assert.NotNil(t, facialReport.Breakdown, "expected breakdown to be set") {
+			faceMatch := facialReport.Breakdown.FaceComparison.Breakdown.FaceMatch
+			if assert.NotNil(t, faceMatch.Properties.Score, "expected face match score to be set") {
+				assert.Equal(t, 0.83, *faceMatch.Properties.Score)
+			}
+			assert.Equal(t, "clear", facialReport.Breakdown.ImageIntegrity.Breakdown.FaceDetected.Result)
+			assert.Equal(t, "clear", facialReport.Breakdown.VisualAuthenticity.Breakdown.LivenessDetected.Result)
+		}
+	})
+
+	t.Run("ReturnErrorOnOtherReport", func(t *testing.T) {
+		_, err := (&onfido.Report{Name: onfido.ReportNameDocument}).AsFacialSimilarityReport()
+		assert.Errorf(t, err, expectedError, "AsFacialSimilarityReport", err)
+	})
+}
+
+func testRetrieveReport(run *testRun) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "resource_not_found",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				report, err := run.client.RetrieveReport(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotNil(t, report, "expected report to be fetched")
+				assert.Equal(t, tt.input, report.ID, "expected report ID to match")
+			})
+		}
+	}
+}
+
+func testResumeReport(run *testRun) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "resource_not_found",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := run.client.ResumeReport(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+			})
+		}
+	}
+}
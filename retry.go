@@ -0,0 +1,83 @@
+package onfido
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/besafe-labs/onfido-go-sdk/internal/httpclient"
+)
+
+// ------------------------------------------------------------------
+//                           RETRY POLICY
+// ------------------------------------------------------------------
+
+// RetryPolicy decides which failed requests are retried and how long the client waits before retrying them.
+//
+// ShouldRetry is called after every attempt with its response, or with the error of an attempt that
+// failed without a response. attempt is the number of attempts made so far, starting at 1.
+// The body of an error response can be read, e.g. with [ResponseError], without consuming it.
+//
+// Requests creating applicants and workflow runs are not idempotent and are never retried.
+type RetryPolicy interface {
+	ShouldRetry(resp *http.Response, err error, attempt int) (time.Duration, bool)
+}
+
+// RetryPolicyFunc is a function implementing [RetryPolicy]
+type RetryPolicyFunc func(resp *http.Response, err error, attempt int) (time.Duration, bool)
+
+// ShouldRetry calls f(resp, err, attempt)
+func (f RetryPolicyFunc) ShouldRetry(resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	return f(resp, err, attempt)
+}
+
+// WithRetryPolicy sets the policy deciding which requests are retried, it takes precedence over [WithRetries]:
+//
+//	policy := onfido.RetryPolicyFunc(func(resp *http.Response, err error, attempt int) (time.Duration, bool) {
+//		if attempt > 3 {
+//			return 0, false
+//		}
+//		if onfidoErr, ok := onfido.ResponseError(resp); ok && onfidoErr.Type == "rate_limit" {
+//			return time.Duration(attempt) * time.Second, true
+//		}
+//		return time.Second, err != nil
+//	})
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *clientOptions) {
+		c.retryPolicy = policy
+	}
+}
+
+// ResponseError decodes the Onfido error of an error response, it returns false if the response
+// is not an error response or its body is not an Onfido error
+func ResponseError(resp *http.Response) (*OnfidoError, bool) {
+	if resp == nil || resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return nil, false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false
+	}
+
+	var onfidoError struct {
+		Error *OnfidoError `json:"error"`
+	}
+	if err := json.Unmarshal(body, &onfidoError); err != nil || onfidoError.Error == nil {
+		return nil, false
+	}
+	return onfidoError.Error, true
+}
+
+// RetryAfter returns the delay asked by the Retry-After header of resp, given either in seconds or as an HTTP date
+func RetryAfter(resp *http.Response) (time.Duration, bool) {
+	return httpclient.RetryAfter(resp, time.Now())
+}
+
+func (c Client) getRetryOption() httpclient.RequestOption {
+	if c.retryPolicy != nil {
+		return httpclient.WithHttpRetryPolicy(c.retryPolicy.ShouldRetry)
+	}
+	return httpclient.WithHttpRetries(c.Retries, c.RetryWait)
+}
@@ -0,0 +1,81 @@
+package onfido_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	conflictThenOK := func(attempts *int) roundTripperFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			*attempts++
+			status, body := http.StatusOK, `{"id":"applicant-id"}`
+			if *attempts == 1 {
+				status, body = http.StatusConflict, `{"error":{"type":"conflict","message":"applicant is being updated"}}`
+			}
+			return &http.Response{
+				StatusCode: status,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Request:    req,
+			}, nil
+		}
+	}
+
+	retryConflicts := onfido.RetryPolicyFunc(func(resp *http.Response, err error, attempt int) (time.Duration, bool) {
+		onfidoErr, ok := onfido.ResponseError(resp)
+		return time.Millisecond, ok && onfidoErr.Type == "conflict" && attempt < 3
+	})
+
+	t.Run("RetryAsDecidedByPolicy", func(t *testing.T) {
+		attempts := 0
+		client, teardown, err := setupClient("token", onfido.WithTransport(conflictThenOK(&attempts)), onfido.WithRetryPolicy(retryConflicts))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		applicant, err := client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.NoErrorf(t, err, expectedNoError, "RetrieveApplicant", err)
+		assert.Equal(t, "applicant-id", applicant.ID)
+		assert.Equal(t, 2, attempts, "expected conflict to be retried")
+	})
+
+	t.Run("KeepErrorBodyWhenNotRetrying", func(t *testing.T) {
+		attempts := 0
+		never := onfido.RetryPolicyFunc(func(resp *http.Response, err error, attempt int) (time.Duration, bool) {
+			onfido.ResponseError(resp)
+			return 0, false
+		})
+		client, teardown, err := setupClient("token", onfido.WithTransport(conflictThenOK(&attempts)), onfido.WithRetryPolicy(never))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.RetrieveApplicant(context.Background(), "applicant-id")
+		assert.Errorf(t, err, expectedError, "RetrieveApplicant", err)
+		assert.Containsf(t, err.Error(), "applicant is being updated", errorContains, "applicant is being updated", err)
+		assert.Equal(t, 1, attempts, "expected no retry")
+	})
+
+	t.Run("NeverRetryCreations", func(t *testing.T) {
+		attempts := 0
+		client, teardown, err := setupClient("token", onfido.WithTransport(conflictThenOK(&attempts)), onfido.WithRetryPolicy(retryConflicts))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.CreateApplicant(context.Background(), onfido.CreateApplicantPayload{FirstName: "John", LastName: "Doe"})
+		assert.Errorf(t, err, expectedError, "CreateApplicant", err)
+		assert.Equal(t, 1, attempts, "expected creation not to be retried")
+	})
+}
@@ -0,0 +1,78 @@
+package onfido
+
+import "context"
+
+// ------------------------------------------------------------------
+//                              SCANNER
+// ------------------------------------------------------------------
+
+// PageFetcher fetches a single page of a list endpoint
+type PageFetcher[T any] func(ctx context.Context, page int) ([]T, *PageDetails, error)
+
+// Scanner reads the items of a paginated list endpoint one at a time, fetching the next
+// page when the current one is exhausted. It follows the ergonomics of [bufio.Scanner]:
+//
+//	scanner := client.ScanApplicants(onfido.WithPageLimit(100))
+//	for scanner.Scan(ctx) {
+//		applicant := scanner.Item()
+//		...
+//	}
+//	if err := scanner.Err(); err != nil {
+//		...
+//	}
+type Scanner[T any] struct {
+	fetch PageFetcher[T]
+	items []T
+	index int
+	page  int
+	done  bool
+	err   error
+}
+
+// NewScanner creates a Scanner over any list endpoint, starting at the first page
+func NewScanner[T any](fetch PageFetcher[T]) *Scanner[T] {
+	return &Scanner[T]{fetch: fetch, index: -1, page: 1}
+}
+
+// Scan advances the scanner to the next item, which will then be available through Item.
+// It returns false when there are no more items or an error occurred, in which case Err
+// returns the error.
+func (s *Scanner[T]) Scan(ctx context.Context) bool {
+	if s.index+1 < len(s.items) {
+		s.index++
+		return true
+	}
+
+	if s.done || s.err != nil {
+		return false
+	}
+
+	items, page, err := s.fetch(ctx, s.page)
+	if err != nil {
+		s.err = err
+		return false
+	}
+
+	s.items, s.index = items, 0
+	if page == nil || page.NextPage == nil || len(items) == 0 {
+		s.done = true
+	} else {
+		s.page = *page.NextPage
+	}
+
+	return len(s.items) > 0
+}
+
+// Item returns the item the scanner is positioned on
+func (s *Scanner[T]) Item() T {
+	var zero T
+	if s.index < 0 || s.index >= len(s.items) {
+		return zero
+	}
+	return s.items[s.index]
+}
+
+// Err returns the first error encountered by the scanner
+func (s *Scanner[T]) Err() error {
+	return s.err
+}
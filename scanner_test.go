@@ -0,0 +1,65 @@
+package onfido_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanner(t *testing.T) {
+	ctx := context.Background()
+	pages := map[int][]int{1: {1, 2}, 2: {3, 4}, 3: {5}}
+
+	fetchPage := func(ctx context.Context, page int) ([]int, *onfido.PageDetails, error) {
+		details := &onfido.PageDetails{}
+		if _, ok := pages[page+1]; ok {
+			next := page + 1
+			details.NextPage = &next
+		}
+		return pages[page], details, nil
+	}
+
+	t.Run("ScanAllPages", func(t *testing.T) {
+		scanner := onfido.NewScanner(fetchPage)
+
+		var items []int
+		for scanner.Scan(ctx) {
+			items = append(items, scanner.Item())
+		}
+
+		assert.NoError(t, scanner.Err(), "expected no error")
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, items, "expected all items to be scanned in order")
+		assert.False(t, scanner.Scan(ctx), "expected scanner to stay exhausted")
+	})
+
+	t.Run("StopOnError", func(t *testing.T) {
+		fetchErr := errors.New("fetch failed")
+		scanner := onfido.NewScanner(func(ctx context.Context, page int) ([]int, *onfido.PageDetails, error) {
+			if page == 2 {
+				return nil, nil, fetchErr
+			}
+			return fetchPage(ctx, page)
+		})
+
+		var items []int
+		for scanner.Scan(ctx) {
+			items = append(items, scanner.Item())
+		}
+
+		assert.ErrorIs(t, scanner.Err(), fetchErr, "expected fetch error to be returned")
+		assert.Equal(t, []int{1, 2}, items, "expected items of the first page to be scanned")
+	})
+
+	t.Run("ScanEmptyList", func(t *testing.T) {
+		scanner := onfido.NewScanner(func(ctx context.Context, page int) ([]int, *onfido.PageDetails, error) {
+			return nil, &onfido.PageDetails{}, nil
+		})
+
+		assert.False(t, scanner.Scan(ctx), "expected no items to be scanned")
+		assert.NoError(t, scanner.Err(), "expected no error")
+		assert.Zero(t, scanner.Item(), "expected zero value item")
+	})
+}
@@ -0,0 +1,102 @@
+package onfido
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// ------------------------------------------------------------------
+//                              SDK TOKEN
+// ------------------------------------------------------------------
+
+// SdkToken represents a token used to bootstrap the Onfido web and mobile capture SDKs
+type SdkToken struct {
+	Token string `json:"token,omitempty"`
+}
+
+// ExpiresAt returns the expiry time carried by the token's exp claim.
+// The boolean is false if the token is not a JWT or has no exp claim.
+func (t SdkToken) ExpiresAt() (time.Time, bool) {
+	segments := strings.Split(t.Token, ".")
+	if len(segments) != 3 {
+		return time.Time{}, false
+	}
+
+	claims, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var payload struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(claims, &payload); err != nil || payload.Exp == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(payload.Exp, 0), true
+}
+
+// Expired reports whether the token has expired. Tokens without expiry metadata are never reported as expired.
+func (t SdkToken) Expired() bool {
+	expiresAt, ok := t.ExpiresAt()
+	return ok && !time.Now().Before(expiresAt)
+}
+
+// GenerateSdkTokenPayload holds the parameters of an SDK token.
+// Exactly one of Referrer (web SDK) or ApplicationID (mobile SDKs) must be set.
+type GenerateSdkTokenPayload struct {
+	// ApplicantID is the id of the applicant the token is generated for, required
+	ApplicantID string `json:"applicant_id,omitempty"`
+	// Referrer is the referrer URL pattern allowed to use the token with the web SDK
+	Referrer string `json:"referrer,omitempty"`
+	// ApplicationID is the application ID (iOS) or application bundle ID (Android) allowed to use the token with the mobile SDKs
+	ApplicationID  string `json:"application_id,omitempty"`
+	CrossDeviceURL string `json:"cross_device_url,omitempty"`
+}
+
+func (p GenerateSdkTokenPayload) validate() error {
+	if p.ApplicantID == "" {
+		return ErrInvalidId
+	}
+	if (p.Referrer == "") == (p.ApplicationID == "") {
+		return ErrInvalidSdkTokenTarget
+	}
+	return nil
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// GenerateSdkToken generates a token for the Onfido capture SDKs
+func (c *Client) GenerateSdkToken(ctx context.Context, payload GenerateSdkTokenPayload) (*SdkToken, error) {
+	if err := payload.validate(); err != nil {
+		return nil, err
+	}
+
+	var sdkToken SdkToken
+
+	req := func() error {
+		body, err := c.buildJSON(payload)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.Post(ctx, "/sdk_token", body, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &sdkToken)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &sdkToken, nil
+}
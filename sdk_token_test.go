@@ -0,0 +1,127 @@
+package onfido_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSdkToken(t *testing.T) {
+	run := setupTestRun(t)
+	defer run.teardown()
+
+	applicant, err := run.client.CreateApplicant(run.ctx, onfido.CreateApplicantPayload{
+		FirstName: "John",
+		LastName:  "SdkTokenTest",
+	})
+	if err != nil {
+		t.Fatalf("error creating applicant: %v", err)
+	}
+
+	t.Run("GenerateSdkToken", testGenerateSdkToken(run, applicant.ID))
+}
+
+func testGenerateSdkToken(run *testRun, applicantId string) func(*testing.T) {
+	tests := []testCase[onfido.GenerateSdkTokenPayload]{
+		{
+			name: "GenerateForWebWithoutErrors",
+			input: onfido.GenerateSdkTokenPayload{
+				ApplicantID: applicantId,
+				Referrer:    "https://*.example.com/onboarding/*",
+			},
+		},
+		{
+			name: "GenerateForMobileWithoutErrors",
+			input: onfido.GenerateSdkTokenPayload{
+				ApplicantID:   applicantId,
+				ApplicationID: "com.example.onboarding",
+			},
+		},
+		{
+			name: "ReturnErrorOnBothReferrerAndApplicationID",
+			input: onfido.GenerateSdkTokenPayload{
+				ApplicantID:   applicantId,
+				Referrer:      "https://*.example.com/onboarding/*",
+				ApplicationID: "com.example.onboarding",
+			},
+			wantErr: true,
+			errMsg:  "exactly one of referrer or application_id",
+		},
+		{
+			name: "ReturnErrorOnMissingReferrerAndApplicationID",
+			input: onfido.GenerateSdkTokenPayload{
+				ApplicantID: applicantId,
+			},
+			wantErr: true,
+			errMsg:  "exactly one of referrer or application_id",
+		},
+		{
+			name:    "ReturnErrorOnEmptyPayload",
+			input:   onfido.GenerateSdkTokenPayload{},
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				sdkToken, err := run.client.GenerateSdkToken(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotEmpty(t, sdkToken.Token, "expected token to be generated")
+
+				expiresAt, ok := sdkToken.ExpiresAt()
+				assert.True(t, ok, "expected token to carry expiry metadata")
+				assert.True(t, expiresAt.After(time.Now()), "expected token to expire in the future")
+			})
+		}
+	}
+}
+
+func TestSdkTokenExpiry(t *testing.T) {
+	encode := func(claims string) string {
+		return "eyJhbGciOiJIUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature"
+	}
+
+	tests := []testCase[string]{
+		{
+			name:  "ReadExpiryFromClaims",
+			input: encode(`{"exp":4102444800}`),
+		},
+		{
+			name:    "ReturnFalseWithoutExpClaim",
+			input:   encode(`{"payload":"data"}`),
+			wantErr: true,
+		},
+		{
+			name:    "ReturnFalseOnMalformedToken",
+			input:   "not-a-jwt",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := onfido.SdkToken{Token: tt.input}
+			expiresAt, ok := token.ExpiresAt()
+			if tt.wantErr {
+				assert.False(t, ok, "expected no expiry metadata")
+				assert.False(t, token.Expired(), "expected token without expiry not to be expired")
+				return
+			}
+
+			assert.True(t, ok, "expected expiry metadata")
+			assert.Equal(t, time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), expiresAt.UTC())
+			assert.False(t, token.Expired(), "expected token not to be expired")
+		})
+	}
+}
@@ -107,7 +107,7 @@ func createTestWorkflowRuns(run *testRun, applicants []*onfido.Applicant, expiry
 			CustomerUserID: fmt.Sprintf("customer-user-id-%d", i),
 			Link: &onfido.CreateWorkflowRunLink{
 				ExpiresAt: &expiry,
-				Language:  "en_GB",
+				Language:  onfido.LinkLanguageEnglishGB,
 			},
 			CustomData: map[string]any{
 				"document_id": []any{},
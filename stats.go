@@ -0,0 +1,140 @@
+package onfido
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/besafe-labs/onfido-go-sdk/internal/httpclient"
+)
+
+// ------------------------------------------------------------------
+//                              STATS
+// ------------------------------------------------------------------
+
+// EndpointStats summarizes the most recent requests made to an endpoint
+type EndpointStats struct {
+	// Endpoint is the method and path template of the endpoint, e.g. "GET /applicants/{id}"
+	Endpoint string
+	// Requests is the number of requests in the rolling window
+	Requests int
+	// SuccessRate is the ratio of successful requests in the rolling window, between 0 and 1.
+	// A request is considered failed if it could not be sent or if its final response is a 429 or a 5xx.
+	SuccessRate float64
+	// P95Latency is the 95th percentile latency of the requests in the rolling window, retries included
+	P95Latency time.Duration
+}
+
+// EndpointStats returns a snapshot of the rolling stats of every endpoint called by the client,
+// sorted by endpoint.
+//
+// It returns nil unless the client was created with [WithEndpointStats].
+func (c *Client) EndpointStats() []EndpointStats {
+	if c.stats == nil {
+		return nil
+	}
+	return c.stats.snapshot()
+}
+
+// ------------------------------------------------------------------
+//                              RECORDER
+// ------------------------------------------------------------------
+
+type statsSample struct {
+	success bool
+	latency time.Duration
+}
+
+type statsWindow struct {
+	samples []statsSample
+	next    int
+}
+
+func (w *statsWindow) add(sample statsSample, size int) {
+	if len(w.samples) < size {
+		w.samples = append(w.samples, sample)
+		return
+	}
+	w.samples[w.next] = sample
+	w.next = (w.next + 1) % size
+}
+
+func (w *statsWindow) summarize(endpoint string) EndpointStats {
+	stats := EndpointStats{Endpoint: endpoint, Requests: len(w.samples)}
+	if len(w.samples) == 0 {
+		return stats
+	}
+
+	successes := 0
+	latencies := make([]time.Duration, len(w.samples))
+	for i, sample := range w.samples {
+		if sample.success {
+			successes++
+		}
+		latencies[i] = sample.latency
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	stats.SuccessRate = float64(successes) / float64(len(w.samples))
+	stats.P95Latency = latencies[(len(latencies)*95+99)/100-1]
+	return stats
+}
+
+type statsRecorder struct {
+	mu      sync.Mutex
+	size    int
+	windows map[string]*statsWindow
+}
+
+func newStatsRecorder(size int) *statsRecorder {
+	return &statsRecorder{size: size, windows: make(map[string]*statsWindow)}
+}
+
+func (r *statsRecorder) record(_ context.Context, info httpclient.RequestInfo) {
+	endpoint := info.Method + " " + endpointTemplate(info.Path)
+	sample := statsSample{
+		success: info.Err == nil && info.StatusCode != http.StatusTooManyRequests && info.StatusCode < http.StatusInternalServerError,
+		latency: info.Duration,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	window, ok := r.windows[endpoint]
+	if !ok {
+		window = &statsWindow{}
+		r.windows[endpoint] = window
+	}
+	window.add(sample, r.size)
+}
+
+func (r *statsRecorder) snapshot() []EndpointStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := make([]EndpointStats, 0, len(r.windows))
+	for endpoint, window := range r.windows {
+		stats = append(stats, window.summarize(endpoint))
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Endpoint < stats[j].Endpoint })
+
+	return stats
+}
+
+var resourceSegment = regexp.MustCompile(`^[a-z_]+$`)
+
+// endpointTemplate replaces the resource ids of a path with placeholders so that
+// requests to the same endpoint are grouped together
+func endpointTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if segment != "" && !resourceSegment.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,73 @@
+package onfido_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointStats(t *testing.T) {
+	var calls int
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodPost {
+			return nil, errors.New("connection reset")
+		}
+
+		calls++
+		status := http.StatusOK
+		if strings.HasPrefix(req.URL.Path, "/v3.6/applicants/") && calls%10 == 0 {
+			status = http.StatusInternalServerError
+		}
+		return &http.Response{StatusCode: status, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(`{}`)), Request: req}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport), onfido.WithRetries(0, 0), onfido.WithEndpointStats(20))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	ctx := context.Background()
+
+	t.Run("ReturnNilWithoutStats", func(t *testing.T) {
+		client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		assert.Nil(t, client.EndpointStats(), "expected no stats")
+	})
+
+	t.Run("SummarizeRollingWindowByEndpointTemplate", func(t *testing.T) {
+		// fill the window twice so that only the last 20 requests are kept
+		for i := 0; i < 40; i++ {
+			client.RetrieveApplicant(ctx, "8a9f3b2c-1d4e-4f5a-9b6c-7d8e9f0a1b2c")
+		}
+		client.DownloadDocumentVideo(ctx, "invalid-id")
+		client.RestoreApplicant(ctx, "8a9f3b2c-1d4e-4f5a-9b6c-7d8e9f0a1b2c")
+		client.CreateApplicant(ctx, onfido.CreateApplicantPayload{FirstName: "John", LastName: "Doe"})
+
+		stats := client.EndpointStats()
+		if assert.Len(t, stats, 4, "expected stats for 4 endpoints") {
+			assert.Equal(t, "GET /applicants/{id}", stats[0].Endpoint)
+			assert.Equal(t, 20, stats[0].Requests, "expected window to be capped")
+			assert.Equal(t, 0.9, stats[0].SuccessRate)
+			assert.Positive(t, stats[0].P95Latency, "expected a latency")
+
+			assert.Equal(t, "GET /documents/{id}/video/download", stats[1].Endpoint)
+
+			assert.Equal(t, "POST /applicants", stats[2].Endpoint)
+			assert.Equal(t, 0.0, stats[2].SuccessRate, "expected requests failing without a response to count as failed")
+
+			assert.Equal(t, "POST /applicants/{id}/restore", stats[3].Endpoint)
+			assert.Equal(t, 1, stats[3].Requests)
+		}
+	})
+}
@@ -0,0 +1,324 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ------------------------------------------------------------------
+//                          WATCHLIST MONITOR
+// ------------------------------------------------------------------
+
+// WatchlistMonitor represents the ongoing AML monitoring of an applicant in the Onfido API
+type WatchlistMonitor struct {
+	ID          string     `json:"id,omitempty"`
+	ApplicantID string     `json:"applicant_id,omitempty"`
+	ReportName  ReportName `json:"report_name,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	IsSandbox   bool       `json:"is_sandbox,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	Href        string     `json:"href,omitempty"`
+	Raw         RawJSON    `json:"-"`
+}
+
+func (w *WatchlistMonitor) UnmarshalJSON(data []byte) error {
+	type watchlistMonitor WatchlistMonitor
+	if err := json.Unmarshal(data, (*watchlistMonitor)(w)); err != nil {
+		return err
+	}
+
+	w.Raw = newRawJSON(data)
+	return nil
+}
+
+// WatchlistMonitorMatch represents a match raised by a watchlist monitor
+type WatchlistMonitorMatch struct {
+	ID      string `json:"id,omitempty"`
+	Enabled bool   `json:"enabled"`
+	// Details holds the remaining attributes of the match as returned by the API
+	Details map[string]any `json:"-"`
+}
+
+func (m *WatchlistMonitorMatch) UnmarshalJSON(data []byte) error {
+	type match WatchlistMonitorMatch
+	var decoded match
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	var details map[string]any
+	if err := json.Unmarshal(data, &details); err != nil {
+		return err
+	}
+	delete(details, "id")
+	delete(details, "enabled")
+	if len(details) > 0 {
+		decoded.Details = details
+	}
+
+	*m = WatchlistMonitorMatch(decoded)
+	return nil
+}
+
+type CreateWatchlistMonitorPayload struct {
+	ApplicantID string `json:"applicant_id,omitempty"`
+	// ReportName is the watchlist report run by the monitor, either ReportNameWatchlistStandard or ReportNameWatchlistAML
+	ReportName ReportName `json:"report_name,omitempty"`
+	Tags       []string   `json:"tags,omitempty"`
+}
+
+// ------------------------------------------------------------------
+//                              OPTIONS
+// ------------------------------------------------------------------
+
+type IsListWatchlistMonitorOption interface {
+	isListWatchlistMonitorOption()
+}
+
+type ListWatchlistMonitorOption func(*listWatchlistMonitorOptions)
+
+func (ListWatchlistMonitorOption) isListWatchlistMonitorOption() {}
+
+type listWatchlistMonitorOptions struct {
+	ApplicantID string `json:"applicant_id,omitempty"`
+	// IncludeDeleted is a flag to include deleted monitors in the response
+	IncludeDeleted bool `json:"include_deleted,omitempty"`
+}
+
+// WithWatchlistMonitorApplicant filters the list of watchlist monitors to those of the specified applicant
+func WithWatchlistMonitorApplicant(applicantId string) ListWatchlistMonitorOption {
+	return func(o *listWatchlistMonitorOptions) {
+		o.ApplicantID = applicantId
+	}
+}
+
+func WithIncludeDeletedWatchlistMonitors() ListWatchlistMonitorOption {
+	return func(o *listWatchlistMonitorOptions) {
+		o.IncludeDeleted = true
+	}
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// CreateWatchlistMonitor creates a watchlist monitor in the Onfido API
+func (c *Client) CreateWatchlistMonitor(ctx context.Context, payload CreateWatchlistMonitorPayload) (*WatchlistMonitor, error) {
+	var monitor WatchlistMonitor
+
+	req := func() error {
+		body, err := c.buildJSON(payload)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.Post(ctx, "/watchlist_monitors", body, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &monitor)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &monitor, nil
+}
+
+// RetrieveWatchlistMonitor retrieves a watchlist monitor from the Onfido API
+func (c *Client) RetrieveWatchlistMonitor(ctx context.Context, monitorId string) (*WatchlistMonitor, error) {
+	if monitorId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var monitor WatchlistMonitor
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/watchlist_monitors/"+monitorId, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &monitor)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &monitor, nil
+}
+
+// ListWatchlistMonitors retrieves a list of watchlist monitors from the Onfido API
+func (c *Client) ListWatchlistMonitors(ctx context.Context, opts ...IsListWatchlistMonitorOption) ([]WatchlistMonitor, error) {
+	var monitors []WatchlistMonitor
+
+	req := func() error {
+		params := c.getListWatchlistMonitorParams(opts...)
+
+		resp, err := c.client.Get(ctx, "/watchlist_monitors", c.getHttpRequestOptions(ctx, params, nil)...)
+		if err != nil {
+			return err
+		}
+
+		var list struct {
+			Monitors []WatchlistMonitor `json:"monitors"`
+		}
+		if err := c.getResponseOrError(resp, &list); err != nil {
+			return err
+		}
+
+		monitors = list.Monitors
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return monitors, nil
+}
+
+// DeleteWatchlistMonitor deletes a watchlist monitor from the Onfido API, which stops the monitoring of the applicant
+func (c *Client) DeleteWatchlistMonitor(ctx context.Context, monitorId string) error {
+	if monitorId == "" {
+		return ErrInvalidId
+	}
+
+	req := func() error {
+		resp, err := c.client.Delete(ctx, "/watchlist_monitors/"+monitorId, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, nil)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListWatchlistMonitorMatches retrieves the matches raised by a watchlist monitor from the Onfido API
+func (c *Client) ListWatchlistMonitorMatches(ctx context.Context, monitorId string) ([]WatchlistMonitorMatch, error) {
+	if monitorId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var matches []WatchlistMonitorMatch
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/watchlist_monitors/"+monitorId+"/matches", c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		var list struct {
+			Matches []WatchlistMonitorMatch `json:"matches"`
+		}
+		if err := c.getResponseOrError(resp, &list); err != nil {
+			return err
+		}
+
+		matches = list.Matches
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// SetWatchlistMonitorMatchesStatus enables and disables matches of a watchlist monitor in the Onfido API
+// and returns the updated matches
+func (c *Client) SetWatchlistMonitorMatchesStatus(ctx context.Context, monitorId string, enableIds, disableIds []string) ([]WatchlistMonitorMatch, error) {
+	if monitorId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var matches []WatchlistMonitorMatch
+
+	req := func() error {
+		body, err := c.buildJSON(struct {
+			Enable  []string `json:"enable,omitempty"`
+			Disable []string `json:"disable,omitempty"`
+		}{enableIds, disableIds})
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.Patch(ctx, "/watchlist_monitors/"+monitorId+"/matches", body, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		var list struct {
+			Matches []WatchlistMonitorMatch `json:"matches"`
+		}
+		if err := c.getResponseOrError(resp, &list); err != nil {
+			return err
+		}
+
+		matches = list.Matches
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// ForceWatchlistMonitorReportCreation triggers a new watchlist report for the applicant of a watchlist monitor in the Onfido API
+func (c *Client) ForceWatchlistMonitorReportCreation(ctx context.Context, monitorId string) error {
+	if monitorId == "" {
+		return ErrInvalidId
+	}
+
+	req := func() error {
+		resp, err := c.client.Post(ctx, "/watchlist_monitors/"+monitorId+"/new_report", nil, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, nil)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c Client) getListWatchlistMonitorParams(opts ...IsListWatchlistMonitorOption) (params map[string]string) {
+	options := &listWatchlistMonitorOptions{}
+
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case ListWatchlistMonitorOption:
+			opt(options)
+		}
+	}
+
+	params = make(map[string]string)
+
+	if options.ApplicantID != "" {
+		params["applicant_id"] = options.ApplicantID
+	}
+
+	if options.IncludeDeleted {
+		params["include_deleted"] = "true"
+	}
+
+	return
+}
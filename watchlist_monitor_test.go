@@ -0,0 +1,315 @@
+package onfido_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchlistMonitor(t *testing.T) {
+	run := setupTestRun(t)
+	defer run.teardown()
+
+	applicant, err := run.client.CreateApplicant(run.ctx, onfido.CreateApplicantPayload{
+		FirstName: "John",
+		LastName:  "WatchlistMonitorTest",
+	})
+	if err != nil {
+		t.Fatalf("error creating applicant: %v", err)
+	}
+
+	testMonitor := &onfido.WatchlistMonitor{}
+
+	t.Run("CreateWatchlistMonitor", testCreateWatchlistMonitor(run, applicant.ID, testMonitor))
+	if testMonitor.ID == "" {
+		t.Fatalf("watchlist monitor ID is empty")
+	}
+	t.Run("RetrieveWatchlistMonitor", testRetrieveWatchlistMonitor(run, testMonitor.ID))
+	t.Run("ListWatchlistMonitors", testListWatchlistMonitors(run, applicant.ID, testMonitor.ID))
+	t.Run("ListWatchlistMonitorMatches", testListWatchlistMonitorMatches(run, testMonitor.ID))
+	t.Run("SetWatchlistMonitorMatchesStatus", testSetWatchlistMonitorMatchesStatus(run, testMonitor.ID))
+	t.Run("ForceWatchlistMonitorReportCreation", testForceWatchlistMonitorReportCreation(run, testMonitor.ID))
+	t.Run("DeleteWatchlistMonitor", testDeleteWatchlistMonitor(run, applicant.ID, testMonitor.ID))
+}
+
+func TestWatchlistMonitorMatch(t *testing.T) {
+	t.Run("KeepMatchDetails", func(t *testing.T) {
+		var match onfido.WatchlistMonitorMatch
+		err := json.Unmarshal([]byte(`{"id":"match-id","enabled":true,"source":"sanctions","score":0.9}`), &match)
+		assert.NoErrorf(t, err, expectedNoError, "Unmarshal", err)
+		assert.Equal(t, "match-id", match.ID)
+		assert.True(t, match.Enabled, "expected match to be enabled")
+		assert.Equal(t, map[string]any{"source": "sanctions", "score": 0.9}, match.Details)
+	})
+
+	t.Run("LeaveDetailsEmpty", func(t *testing.T) {
+		var match onfido.WatchlistMonitorMatch
+		err := json.Unmarshal([]byte(`{"id":"match-id","enabled":false}`), &match)
+		assert.NoErrorf(t, err, expectedNoError, "Unmarshal", err)
+		assert.False(t, match.Enabled, "expected match to be disabled")
+		assert.Nil(t, match.Details, "expected no match details")
+	})
+}
+
+func testCreateWatchlistMonitor(run *testRun, applicantId string, setMonitor *onfido.WatchlistMonitor) func(*testing.T) {
+	tests := []testCase[onfido.CreateWatchlistMonitorPayload]{
+		{
+			name: "CreateWithoutErrors",
+			input: onfido.CreateWatchlistMonitorPayload{
+				ApplicantID: applicantId,
+				ReportName:  onfido.ReportNameWatchlistStandard,
+				Tags:        []string{"go-sdk-test"},
+			},
+		},
+		{
+			name:    "ReturnErrorOnEmptyPayload",
+			input:   onfido.CreateWatchlistMonitorPayload{},
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				monitor, err := run.client.CreateWatchlistMonitor(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+				if err != nil {
+					t.Fatalf("error creating watchlist monitor: %v", err)
+				}
+
+				// Set the monitor for later tests
+				*setMonitor = *monitor
+
+				assert.NotEmpty(t, monitor.ID, "expected watchlist monitor ID to be set")
+				assert.Equal(t, tt.input.ApplicantID, monitor.ApplicantID, "expected applicant ID to match")
+				assert.Equal(t, tt.input.ReportName, monitor.ReportName, "expected report name to match")
+				assert.Equal(t, tt.input.Tags, monitor.Tags, "expected tags to match")
+			})
+		}
+	}
+}
+
+func testRetrieveWatchlistMonitor(run *testRun, monitorId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "RetrieveWithoutErrors",
+			input: monitorId,
+		},
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				monitor, err := run.client.RetrieveWatchlistMonitor(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotNil(t, monitor, "expected watchlist monitor to be fetched")
+				assert.Equal(t, tt.input, monitor.ID, "expected watchlist monitor ID to match")
+			})
+		}
+	}
+}
+
+func testListWatchlistMonitors(run *testRun, applicantId, monitorId string) func(*testing.T) {
+	tests := []testCase[[]onfido.IsListWatchlistMonitorOption]{
+		{
+			name:  "ListForApplicant",
+			input: []onfido.IsListWatchlistMonitorOption{onfido.WithWatchlistMonitorApplicant(applicantId)},
+		},
+		{
+			name: "ListIncludingDeleted",
+			input: []onfido.IsListWatchlistMonitorOption{
+				onfido.WithWatchlistMonitorApplicant(applicantId),
+				onfido.WithIncludeDeletedWatchlistMonitors(),
+			},
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				monitors, err := run.client.ListWatchlistMonitors(run.ctx, tt.input...)
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+
+				found := false
+				for _, monitor := range monitors {
+					assert.Equal(t, applicantId, monitor.ApplicantID, "expected monitor to belong to correct applicant")
+					if monitor.ID == monitorId {
+						found = true
+					}
+				}
+				assert.True(t, found, "expected created watchlist monitor to be listed")
+			})
+		}
+	}
+}
+
+func testListWatchlistMonitorMatches(run *testRun, monitorId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "ListWithoutErrors",
+			input: monitorId,
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				matches, err := run.client.ListWatchlistMonitorMatches(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				for _, match := range matches {
+					assert.NotEmpty(t, match.ID, "expected match ID to be set")
+				}
+			})
+		}
+	}
+}
+
+func testSetWatchlistMonitorMatchesStatus(run *testRun, monitorId string) func(*testing.T) {
+	return func(t *testing.T) {
+		sleep(t, 5)
+		matches, err := run.client.ListWatchlistMonitorMatches(run.ctx, monitorId)
+		if err != nil {
+			t.Fatalf("error listing watchlist monitor matches: %v", err)
+		}
+
+		var disableIds []string
+		for _, match := range matches {
+			disableIds = append(disableIds, match.ID)
+		}
+
+		t.Run("DisableMatchesWithoutErrors", func(t *testing.T) {
+			updated, err := run.client.SetWatchlistMonitorMatchesStatus(run.ctx, monitorId, nil, disableIds)
+			assert.NoErrorf(t, err, expectedNoError, "DisableMatchesWithoutErrors", err)
+			for _, match := range updated {
+				assert.False(t, match.Enabled, "expected match to be disabled")
+			}
+		})
+
+		t.Run("ReturnErrorOnEmptyID", func(t *testing.T) {
+			_, err := run.client.SetWatchlistMonitorMatchesStatus(run.ctx, "", nil, disableIds)
+			assert.Errorf(t, err, expectedError, "ReturnErrorOnEmptyID", err)
+			assert.Containsf(t, err.Error(), "validation_error", errorContains, "validation_error", err.Error())
+		})
+	}
+}
+
+func testForceWatchlistMonitorReportCreation(run *testRun, monitorId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "ForceWithoutErrors",
+			input: monitorId,
+		},
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := run.client.ForceWatchlistMonitorReportCreation(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+			})
+		}
+	}
+}
+
+func testDeleteWatchlistMonitor(run *testRun, applicantId, monitorId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "DeleteWithoutErrors",
+			input: monitorId,
+		},
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "bad_request",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := run.client.DeleteWatchlistMonitor(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+
+				// Verify deletion
+				monitors, err := run.client.ListWatchlistMonitors(run.ctx, onfido.WithWatchlistMonitorApplicant(applicantId))
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				for _, monitor := range monitors {
+					assert.NotEqual(t, tt.input, monitor.ID, "expected deleted monitor not to be listed")
+				}
+			})
+		}
+	}
+}
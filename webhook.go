@@ -0,0 +1,299 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ------------------------------------------------------------------
+//                              WEBHOOK
+// ------------------------------------------------------------------
+
+// Webhook represents a webhook in the Onfido API
+type Webhook struct {
+	ID      string `json:"id,omitempty"`
+	URL     string `json:"url,omitempty"`
+	Enabled bool   `json:"enabled"`
+	// Token is the signing token of the webhook, used to verify the events it sends
+	Token          string               `json:"token,omitempty"`
+	Events         []WebhookEventType   `json:"events,omitempty"`
+	Environments   []WebhookEnvironment `json:"environments,omitempty"`
+	PayloadVersion int                  `json:"payload_version,omitempty"`
+	Href           string               `json:"href,omitempty"`
+	Raw            RawJSON              `json:"-"`
+}
+
+func (w *Webhook) UnmarshalJSON(data []byte) error {
+	type webhook Webhook
+	if err := json.Unmarshal(data, (*webhook)(w)); err != nil {
+		return err
+	}
+
+	w.Raw = newRawJSON(data)
+	return nil
+}
+
+type CreateWebhookPayload struct {
+	// URL is the url the events are sent to, required
+	URL string `json:"url,omitempty"`
+	// Enabled defaults to true when nil
+	Enabled *bool `json:"enabled,omitempty"`
+	// Events defaults to all events when empty
+	Events []WebhookEventType `json:"events,omitempty"`
+	// Environments defaults to all environments when empty
+	Environments   []WebhookEnvironment `json:"environments,omitempty"`
+	PayloadVersion int                  `json:"payload_version,omitempty"`
+}
+
+// WebhookEventType represents the type of an event sent by webhooks, it is the action of incoming events
+type WebhookEventType string
+
+const (
+	WebhookEventAuditLogCreated                   WebhookEventType = "audit_log.created"
+	WebhookEventWatchlistMonitorMatchesUpdated    WebhookEventType = "watchlist_monitor.matches_updated"
+	WebhookEventWorkflowRunCompleted              WebhookEventType = "workflow_run.completed"
+	WebhookEventWorkflowTaskStarted               WebhookEventType = "workflow_task.started"
+	WebhookEventWorkflowTaskCompleted             WebhookEventType = "workflow_task.completed"
+	WebhookEventWorkflowTimelineFileCreated       WebhookEventType = "workflow_timeline_file.created"
+	WebhookEventWorkflowSignedEvidenceFileCreated WebhookEventType = "workflow_signed_evidence_file.created"
+	WebhookEventWorkflowRunEvidenceFolderCreated  WebhookEventType = "workflow_run_evidence_folder.created"
+	WebhookEventCheckStarted                      WebhookEventType = "check.started"
+	WebhookEventCheckReopened                     WebhookEventType = "check.reopened"
+	WebhookEventCheckWithdrawn                    WebhookEventType = "check.withdrawn"
+	WebhookEventCheckCompleted                    WebhookEventType = "check.completed"
+	WebhookEventCheckFormCompleted                WebhookEventType = "check.form_completed"
+	WebhookEventReportWithdrawn                   WebhookEventType = "report.withdrawn"
+	WebhookEventReportResumed                     WebhookEventType = "report.resumed"
+	WebhookEventReportCancelled                   WebhookEventType = "report.cancelled"
+	WebhookEventReportAwaitingApproval            WebhookEventType = "report.awaiting_approval"
+	WebhookEventReportCompleted                   WebhookEventType = "report.completed"
+)
+
+// WebhookEnvironment represents the environment a webhook receives events from
+type WebhookEnvironment string
+
+const (
+	WebhookEnvironmentSandbox WebhookEnvironment = "sandbox"
+	WebhookEnvironmentLive    WebhookEnvironment = "live"
+)
+
+// ------------------------------------------------------------------
+//                              EVENT
+// ------------------------------------------------------------------
+
+// WebhookEvent is an event sent by a webhook
+type WebhookEvent struct {
+	ResourceType WebhookResourceType `json:"resource_type,omitempty"`
+	Action       WebhookEventType    `json:"action,omitempty"`
+	Object       WebhookEventObject  `json:"object"`
+}
+
+// WebhookEventObject describes the resource an event is about
+type WebhookEventObject struct {
+	ID          string     `json:"id,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	CompletedAt *time.Time `json:"completed_at_iso8601,omitempty"`
+	Href        string     `json:"href,omitempty"`
+}
+
+// WebhookResourceType represents the type of the resource an event is about
+//   - The resource types declared here are not exhaustive, the API may send more types
+type WebhookResourceType string
+
+const (
+	WebhookResourceCheck            WebhookResourceType = "check"
+	WebhookResourceReport           WebhookResourceType = "report"
+	WebhookResourceWorkflowRun      WebhookResourceType = "workflow_run"
+	WebhookResourceWorkflowTask     WebhookResourceType = "workflow_task"
+	WebhookResourceWatchlistMonitor WebhookResourceType = "watchlist_monitor"
+	WebhookResourceAuditLog         WebhookResourceType = "audit_log"
+)
+
+// ParseWebhookEvent decodes the body of a request sent by a webhook.
+//
+// It does not verify the signature of the event, use [VerifyWebhookSignature] beforehand.
+func ParseWebhookEvent(body []byte) (*WebhookEvent, error) {
+	var envelope struct {
+		Payload *WebhookEvent `json:"payload"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook event: %w", err)
+	}
+
+	if envelope.Payload == nil || envelope.Payload.Action == "" {
+		return nil, fmt.Errorf("invalid webhook event: missing payload action")
+	}
+
+	return envelope.Payload, nil
+}
+
+// WebhookEventResource is the resource an event is about, only the field matching
+// the resource type of the event is set
+type WebhookEventResource struct {
+	WorkflowRun *WorkflowRun
+	Check       *Check
+	Report      *Report
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// CreateWebhook creates a new webhook in the Onfido API
+func (c *Client) CreateWebhook(ctx context.Context, payload CreateWebhookPayload) (*Webhook, error) {
+	var webhook Webhook
+
+	req := func() error {
+		body, err := c.buildJSON(payload)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.Post(ctx, "/webhooks", body, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &webhook)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// UpdateWebhook updates an existing webhook in the Onfido API, keeping its signing token
+func (c *Client) UpdateWebhook(ctx context.Context, webhookId string, payload CreateWebhookPayload) (*Webhook, error) {
+	if webhookId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var webhook Webhook
+
+	req := func() error {
+		body, err := c.buildJSON(payload)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.Put(ctx, "/webhooks/"+webhookId, body, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &webhook)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// RetrieveWebhook retrieves a webhook from the Onfido API
+func (c *Client) RetrieveWebhook(ctx context.Context, webhookId string) (*Webhook, error) {
+	if webhookId == "" {
+		return nil, ErrInvalidId
+	}
+
+	var webhook Webhook
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/webhooks/"+webhookId, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &webhook)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// ListWebhooks retrieves all the webhooks from the Onfido API
+func (c *Client) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	var webhooks []Webhook
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/webhooks", c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		var list struct {
+			Webhooks []Webhook `json:"webhooks"`
+		}
+		if err := c.getResponseOrError(resp, &list); err != nil {
+			return err
+		}
+
+		webhooks = list.Webhooks
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook deletes a webhook from the Onfido API
+func (c *Client) DeleteWebhook(ctx context.Context, webhookId string) error {
+	if webhookId == "" {
+		return ErrInvalidId
+	}
+
+	req := func() error {
+		resp, err := c.client.Delete(ctx, "/webhooks/"+webhookId, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, nil)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResolveWebhookEvent retrieves the resource an event is about from the Onfido API.
+//
+// Workflow run, check and report events are supported.
+func (c *Client) ResolveWebhookEvent(ctx context.Context, event *WebhookEvent) (*WebhookEventResource, error) {
+	if event == nil {
+		return nil, &ValidationError{Field: "event", Message: "event is required"}
+	}
+
+	var resource WebhookEventResource
+	var err error
+
+	switch event.ResourceType {
+	case WebhookResourceWorkflowRun:
+		resource.WorkflowRun, err = c.RetrieveWorkflowRun(ctx, event.Object.ID)
+	case WebhookResourceCheck:
+		resource.Check, err = c.RetrieveCheck(ctx, event.Object.ID)
+	case WebhookResourceReport:
+		resource.Report, err = c.RetrieveReport(ctx, event.Object.ID)
+	default:
+		return nil, fmt.Errorf("unsupported webhook resource type %q", event.ResourceType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &resource, nil
+}
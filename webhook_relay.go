@@ -0,0 +1,288 @@
+package onfido
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// WebhookSignatureHeader is the header holding the signature of the webhook events sent by Onfido
+const WebhookSignatureHeader = "X-SHA2-Signature"
+
+// maxWebhookBodySize is the maximum size of a webhook event accepted by the relay
+const maxWebhookBodySize = 1 << 20
+
+// VerifyWebhookSignature reports whether signature is the hex encoded HMAC SHA-256 of the body
+// keyed with the token of the webhook
+func VerifyWebhookSignature(body []byte, signature, token string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// ------------------------------------------------------------------
+//                           WEBHOOK RELAY
+// ------------------------------------------------------------------
+
+// WebhookRelay is an [http.Handler] receiving the webhook events sent by Onfido and forwarding the
+// verified ones to another URL, typically a local development server.
+//
+// When a journal file is configured, every verified event is appended to it before being forwarded,
+// so events that could not be delivered can be sent again with [WebhookRelay.Replay].
+type WebhookRelay struct {
+	token     string
+	forwardTo string
+	client    *http.Client
+	retries   int
+	retryWait time.Duration
+	journal   string
+
+	mu  sync.Mutex
+	seq atomic.Uint64
+}
+
+// webhookJournalEntry is a line of the journal, either a received event or a delivery receipt
+type webhookJournalEntry struct {
+	ID         string     `json:"id"`
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+	Signature  string     `json:"signature,omitempty"`
+	// Body is the event as received, base64 encoded in the journal so that replays send the signed bytes
+	Body      []byte `json:"body,omitempty"`
+	Delivered bool   `json:"delivered,omitempty"`
+}
+
+// ------------------------------------------------------------------
+//                              OPTIONS
+// ------------------------------------------------------------------
+
+type WebhookRelayOption func(*WebhookRelay)
+
+// WithRelayRetries sets how many times, and how long apart, the delivery of an event is retried
+func WithRelayRetries(retries int, wait time.Duration) WebhookRelayOption {
+	return func(r *WebhookRelay) {
+		r.retries = retries
+		r.retryWait = wait
+	}
+}
+
+// WithRelayJournal sets the file the received events are journaled to
+func WithRelayJournal(path string) WebhookRelayOption {
+	return func(r *WebhookRelay) {
+		r.journal = path
+	}
+}
+
+// WithRelayHTTPClient sets the HTTP client used to forward the events
+func WithRelayHTTPClient(client *http.Client) WebhookRelayOption {
+	return func(r *WebhookRelay) {
+		r.client = client
+	}
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// NewWebhookRelay creates a relay verifying events with the webhook token and forwarding them to forwardTo
+func NewWebhookRelay(token, forwardTo string, opts ...WebhookRelayOption) (*WebhookRelay, error) {
+	if token == "" {
+		return nil, fmt.Errorf("token is required")
+	}
+	if forwardTo == "" {
+		return nil, fmt.Errorf("forwardTo is required")
+	}
+
+	r := &WebhookRelay{
+		token:     token,
+		forwardTo: forwardTo,
+		client:    &http.Client{Timeout: 30 * time.Second},
+		retryWait: time.Second,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r, nil
+}
+
+// ServeHTTP verifies the signature of the event, journals it and forwards it.
+//
+// Events with an invalid signature are rejected with a 401. When no journal is configured, a failed delivery
+// is answered with a 502 so that Onfido sends the event again.
+func (r *WebhookRelay) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxWebhookBodySize))
+	if err != nil {
+		http.Error(w, "unable to read body", http.StatusBadRequest)
+		return
+	}
+
+	signature := req.Header.Get(WebhookSignatureHeader)
+	if !VerifyWebhookSignature(body, signature, r.token) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	now := time.Now().UTC()
+	id := fmt.Sprintf("%d-%d", now.UnixNano(), r.seq.Add(1))
+	entry := webhookJournalEntry{ID: id, ReceivedAt: &now, Signature: signature, Body: body}
+	if err := r.appendJournal(entry); err != nil {
+		http.Error(w, "unable to journal event", http.StatusInternalServerError)
+		return
+	}
+
+	if err := r.deliver(req.Context(), entry); err != nil && r.journal == "" {
+		http.Error(w, "unable to forward event", http.StatusBadGateway)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Replay forwards again the journaled events that were never delivered and returns how many were delivered
+func (r *WebhookRelay) Replay(ctx context.Context) (int, error) {
+	if r.journal == "" {
+		return 0, fmt.Errorf("no journal configured")
+	}
+
+	pending, err := r.pendingEvents()
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	var errs []error
+	for _, entry := range pending {
+		if err := r.deliver(ctx, entry); err != nil {
+			errs = append(errs, fmt.Errorf("event %s: %w", entry.ID, err))
+			continue
+		}
+		delivered++
+	}
+
+	return delivered, errors.Join(errs...)
+}
+
+// deliver forwards the event, retrying as configured, and records the delivery in the journal
+func (r *WebhookRelay) deliver(ctx context.Context, entry webhookJournalEntry) error {
+	var lastErr error
+	for attempt := 0; attempt <= r.retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(r.retryWait):
+			}
+		}
+
+		if lastErr = r.forward(ctx, entry); lastErr == nil {
+			return r.appendJournal(webhookJournalEntry{ID: entry.ID, Delivered: true})
+		}
+	}
+
+	return fmt.Errorf("failed to forward event after %d retries: %w", r.retries, lastErr)
+}
+
+func (r *WebhookRelay) forward(ctx context.Context, entry webhookJournalEntry) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.forwardTo, bytes.NewReader(entry.Body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookSignatureHeader, entry.Signature)
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+	return nil
+}
+
+func (r *WebhookRelay) appendJournal(entry webhookJournalEntry) error {
+	if r.journal == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal journal entry: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file, err := os.OpenFile(r.journal, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open journal: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write journal: %w", err)
+	}
+	return nil
+}
+
+func (r *WebhookRelay) pendingEvents() ([]webhookJournalEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file, err := os.Open(r.journal)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open journal: %w", err)
+	}
+	defer file.Close()
+
+	var events []webhookJournalEntry
+	delivered := make(map[string]bool)
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 2*maxWebhookBodySize)
+	for scanner.Scan() {
+		var entry webhookJournalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("failed to decode journal entry: %w", err)
+		}
+
+		if entry.Delivered {
+			delivered[entry.ID] = true
+			continue
+		}
+		events = append(events, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read journal: %w", err)
+	}
+
+	pending := events[:0]
+	for _, entry := range events {
+		if !delivered[entry.ID] {
+			pending = append(pending, entry)
+		}
+	}
+	return pending, nil
+}
@@ -0,0 +1,134 @@
+package onfido_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+const webhookToken = "webhook-token"
+
+func signWebhook(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(webhookToken))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func sendWebhook(relay http.Handler, body []byte, signature string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set(onfido.WebhookSignatureHeader, signature)
+	rec := httptest.NewRecorder()
+	relay.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestWebhookRelay(t *testing.T) {
+	body := []byte(`{"payload":{"resource_type":"check","action":"check.completed"}}`)
+
+	t.Run("VerifySignature", func(t *testing.T) {
+		assert.True(t, onfido.VerifyWebhookSignature(body, signWebhook(body), webhookToken), "expected signature to be valid")
+		assert.False(t, onfido.VerifyWebhookSignature(body, signWebhook([]byte("{}")), webhookToken), "expected signature to be invalid")
+		assert.False(t, onfido.VerifyWebhookSignature(body, "not-hex", webhookToken), "expected malformed signature to be invalid")
+	})
+
+	t.Run("ForwardVerifiedEvents", func(t *testing.T) {
+		var received [][]byte
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			received = append(received, b)
+			assert.Equal(t, signWebhook(b), r.Header.Get(onfido.WebhookSignatureHeader), "expected signature to be forwarded")
+		}))
+		defer target.Close()
+
+		relay, err := onfido.NewWebhookRelay(webhookToken, target.URL)
+		if err != nil {
+			t.Fatalf("error creating relay: %v", err)
+		}
+
+		rec := sendWebhook(relay, body, signWebhook(body))
+		assert.Equal(t, http.StatusOK, rec.Code, "expected event to be accepted")
+
+		rec = sendWebhook(relay, body, "invalid")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code, "expected event with invalid signature to be rejected")
+
+		assert.Equal(t, [][]byte{body}, received, "expected only the verified event to be forwarded")
+	})
+
+	t.Run("ReplayUndeliveredEvents", func(t *testing.T) {
+		available := false
+		delivered := 0
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !available {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			delivered++
+		}))
+		defer target.Close()
+
+		relay, err := onfido.NewWebhookRelay(webhookToken, target.URL,
+			onfido.WithRelayJournal(filepath.Join(t.TempDir(), "journal.jsonl")),
+			onfido.WithRelayRetries(1, time.Millisecond))
+		if err != nil {
+			t.Fatalf("error creating relay: %v", err)
+		}
+
+		rec := sendWebhook(relay, body, signWebhook(body))
+		assert.Equal(t, http.StatusOK, rec.Code, "expected journaled event to be accepted")
+		assert.Equal(t, 0, delivered, "expected event not to be delivered")
+
+		available = true
+		count, err := relay.Replay(context.Background())
+		assert.NoErrorf(t, err, expectedNoError, "Replay", err)
+		assert.Equal(t, 1, count, "expected journaled event to be replayed")
+		assert.Equal(t, 1, delivered, "expected event to be delivered")
+
+		count, err = relay.Replay(context.Background())
+		assert.NoErrorf(t, err, expectedNoError, "Replay", err)
+		assert.Equal(t, 0, count, "expected delivered event not to be replayed")
+	})
+
+	t.Run("ReplaySignedBytes", func(t *testing.T) {
+		// escaped by encoding/json and not compact, the body must still be replayed byte for byte
+		body := []byte(`{"payload": {"resource_type": "check", "object": {"href": "https://x/?a=1&b=<2>"}}}`)
+
+		available := false
+		var verified []bool
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !available {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			b, _ := io.ReadAll(r.Body)
+			verified = append(verified, onfido.VerifyWebhookSignature(b, r.Header.Get(onfido.WebhookSignatureHeader), webhookToken))
+		}))
+		defer target.Close()
+
+		relay, err := onfido.NewWebhookRelay(webhookToken, target.URL,
+			onfido.WithRelayJournal(filepath.Join(t.TempDir(), "journal.jsonl")),
+			onfido.WithRelayRetries(1, time.Millisecond))
+		if err != nil {
+			t.Fatalf("error creating relay: %v", err)
+		}
+
+		rec := sendWebhook(relay, body, signWebhook(body))
+		assert.Equal(t, http.StatusOK, rec.Code, "expected journaled event to be accepted")
+
+		available = true
+		count, err := relay.Replay(context.Background())
+		assert.NoErrorf(t, err, expectedNoError, "Replay", err)
+		assert.Equal(t, 1, count, "expected journaled event to be replayed")
+		assert.Equal(t, []bool{true}, verified, "expected the replayed event to carry a valid signature")
+	})
+}
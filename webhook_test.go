@@ -0,0 +1,297 @@
+package onfido_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhook(t *testing.T) {
+	run := setupTestRun(t)
+	defer run.teardown()
+
+	testWebhook := &onfido.Webhook{}
+
+	t.Run("CreateWebhook", testCreateWebhook(run, testWebhook))
+	if testWebhook.ID == "" {
+		t.Fatalf("webhook ID is empty")
+	}
+	t.Run("RetrieveWebhook", testRetrieveWebhook(run, testWebhook.ID))
+	t.Run("ListWebhooks", testListWebhooks(run, testWebhook.ID))
+	t.Run("UpdateWebhook", testUpdateWebhook(run, testWebhook))
+	t.Run("DeleteWebhook", testDeleteWebhook(run, testWebhook.ID))
+}
+
+func TestParseWebhookEvent(t *testing.T) {
+	tests := []testCase[string]{
+		{
+			name: "ParseWithoutErrors",
+			input: `{"payload": {"resource_type": "check", "action": "check.completed", "object": {
+				"id": "5345badd-f5a8-4f53-9e0f-4ad7e1ed5a1d",
+				"status": "complete",
+				"completed_at_iso8601": "2019-10-28T15:00:39Z",
+				"href": "https://api.eu.onfido.com/v3.6/checks/5345badd-f5a8-4f53-9e0f-4ad7e1ed5a1d"
+			}}}`,
+		},
+		{
+			name:    "ReturnErrorOnInvalidJSON",
+			input:   `{"payload":`,
+			wantErr: true,
+			errMsg:  "failed to decode",
+		},
+		{
+			name:    "ReturnErrorOnMissingPayload",
+			input:   `{"resource_type": "check"}`,
+			wantErr: true,
+			errMsg:  "missing payload",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := onfido.ParseWebhookEvent([]byte(tt.input))
+			if tt.wantErr {
+				assert.Errorf(t, err, expectedError, tt.name, err)
+				assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+				return
+			}
+
+			assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+			assert.Equal(t, onfido.WebhookResourceCheck, event.ResourceType)
+			assert.Equal(t, onfido.WebhookEventCheckCompleted, event.Action)
+			assert.Equal(t, "5345badd-f5a8-4f53-9e0f-4ad7e1ed5a1d", event.Object.ID)
+			assert.Equal(t, "complete", event.Object.Status)
+			if assert.NotNil(t, event.Object.CompletedAt, "expected completed at to be set") {
+				assert.Equal(t, 2019, event.Object.CompletedAt.Year())
+			}
+		})
+	}
+}
+
+func TestResolveWebhookEvent(t *testing.T) {
+	client, teardown, err := setupClient("token")
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	t.Run("ReturnErrorOnUnsupportedResourceType", func(t *testing.T) {
+		_, err := client.ResolveWebhookEvent(context.Background(), &onfido.WebhookEvent{
+			ResourceType: onfido.WebhookResourceAuditLog,
+			Action:       onfido.WebhookEventAuditLogCreated,
+		})
+		assert.Errorf(t, err, expectedError, "ResolveWebhookEvent", err)
+		assert.Containsf(t, err.Error(), "unsupported", errorContains, "unsupported", err.Error())
+	})
+
+	t.Run("ReturnErrorOnMissingObjectID", func(t *testing.T) {
+		_, err := client.ResolveWebhookEvent(context.Background(), &onfido.WebhookEvent{
+			ResourceType: onfido.WebhookResourceCheck,
+			Action:       onfido.WebhookEventCheckCompleted,
+		})
+		assert.Errorf(t, err, expectedError, "ResolveWebhookEvent", err)
+		assert.Containsf(t, err.Error(), "validation_error", errorContains, "validation_error", err.Error())
+	})
+}
+
+func testCreateWebhook(run *testRun, setWebhook *onfido.Webhook) func(*testing.T) {
+	tests := []testCase[onfido.CreateWebhookPayload]{
+		{
+			name: "CreateWithoutErrors",
+			input: onfido.CreateWebhookPayload{
+				URL:    "https://example.com/onfido/webhooks",
+				Events: []onfido.WebhookEventType{onfido.WebhookEventWorkflowRunCompleted},
+			},
+		},
+		{
+			name:    "ReturnErrorOnEmptyPayload",
+			input:   onfido.CreateWebhookPayload{},
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				webhook, err := run.client.CreateWebhook(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+				if err != nil {
+					t.Fatalf("error creating webhook: %v", err)
+				}
+
+				// Set the webhook for later tests
+				*setWebhook = *webhook
+
+				assert.NotEmpty(t, webhook.ID, "expected webhook ID to be set")
+				assert.NotEmpty(t, webhook.Token, "expected webhook token to be set")
+				assert.Equal(t, tt.input.URL, webhook.URL, "expected webhook url to match")
+				assert.True(t, webhook.Enabled, "expected webhook to be enabled by default")
+			})
+		}
+	}
+}
+
+func testRetrieveWebhook(run *testRun, webhookId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "RetrieveWithoutErrors",
+			input: webhookId,
+		},
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "resource_not_found",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				webhook, err := run.client.RetrieveWebhook(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotNil(t, webhook, "expected webhook to be fetched")
+				assert.Equal(t, tt.input, webhook.ID, "expected webhook ID to match")
+			})
+		}
+	}
+}
+
+func testListWebhooks(run *testRun, webhookId string) func(*testing.T) {
+	return func(t *testing.T) {
+		sleep(t, 5)
+		webhooks, err := run.client.ListWebhooks(run.ctx)
+		assert.NoError(t, err, "expected no error listing webhooks")
+
+		found := false
+		for _, webhook := range webhooks {
+			if webhook.ID == webhookId {
+				found = true
+			}
+		}
+		assert.True(t, found, "expected created webhook to be listed")
+	}
+}
+
+func testUpdateWebhook(run *testRun, webhook *onfido.Webhook) func(*testing.T) {
+	disabled := false
+	tests := []testCase[struct {
+		id      string
+		payload onfido.CreateWebhookPayload
+	}]{
+		{
+			name: "UpdateWithoutErrors",
+			input: struct {
+				id      string
+				payload onfido.CreateWebhookPayload
+			}{
+				id: webhook.ID,
+				payload: onfido.CreateWebhookPayload{
+					URL:     "https://example.com/onfido/webhooks/v2",
+					Enabled: &disabled,
+				},
+			},
+		},
+		{
+			name: "ReturnErrorOnInvalidID",
+			input: struct {
+				id      string
+				payload onfido.CreateWebhookPayload
+			}{
+				id: "invalid-id",
+			},
+			wantErr: true,
+			errMsg:  "resource_not_found",
+		},
+		{
+			name: "ReturnErrorOnEmptyID",
+			input: struct {
+				id      string
+				payload onfido.CreateWebhookPayload
+			}{},
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				updatedWebhook, err := run.client.UpdateWebhook(run.ctx, tt.input.id, tt.input.payload)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.Equal(t, tt.input.payload.URL, updatedWebhook.URL, "expected url to be updated")
+				assert.False(t, updatedWebhook.Enabled, "expected webhook to be disabled")
+				assert.Equal(t, webhook.Token, updatedWebhook.Token, "expected signing token to be kept")
+			})
+		}
+	}
+}
+
+func testDeleteWebhook(run *testRun, webhookId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "DeleteWithoutErrors",
+			input: webhookId,
+		},
+		{
+			name:    "ReturnErrorOnInvalidID",
+			input:   "invalid-id",
+			wantErr: true,
+			errMsg:  "resource_not_found",
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := run.client.DeleteWebhook(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+
+				// Verify deletion
+				webhook, err := run.client.RetrieveWebhook(run.ctx, tt.input)
+				assert.Errorf(t, err, expectedError, tt.name, err)
+				assert.Nil(t, webhook, "expected webhook to be deleted")
+			})
+		}
+	}
+}
@@ -2,6 +2,7 @@ package onfido
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -28,8 +29,23 @@ type WorkflowRun struct {
 	Link              *WorkflowRunLink  `json:"link,omitempty"`
 	CreatedAt         *time.Time        `json:"created_at,omitempty"`
 	UpdatedAt         *time.Time        `json:"updated_at,omitempty"`
+	Raw               RawJSON           `json:"-"`
 }
 
+func (w *WorkflowRun) UnmarshalJSON(data []byte) error {
+	type workflowRun WorkflowRun
+	if err := json.Unmarshal(data, (*workflowRun)(w)); err != nil {
+		return err
+	}
+
+	w.Raw = newRawJSON(data)
+	return nil
+}
+
+// WorkflowRunLink is the link sent to the applicant to complete a workflow run.
+//
+// The Onfido API has no endpoint to update or regenerate the link of an existing workflow run:
+// once the link has expired, a new workflow run must be created for the applicant.
 type WorkflowRunLink struct {
 	URL                   string `json:"url,omitempty"`
 	CreateWorkflowRunLink `json:",inline"`
@@ -48,6 +64,27 @@ const (
 	WorkflowRunStatusError         WorkflowRunStatus = "error"
 )
 
+// IsTerminal reports whether the workflow run reached its final decision, i.e. approved, declined,
+// abandoned or error. A run in review is not terminal, it is still to be approved or declined
+// manually from the dashboard.
+func (s WorkflowRunStatus) IsTerminal() bool {
+	switch s {
+	case WorkflowRunStatusApproved, WorkflowRunStatusDeclined, WorkflowRunStatusAbandoned, WorkflowRunStatusError:
+		return true
+	}
+	return false
+}
+
+// IsApproved reports whether the workflow run was approved
+func (s WorkflowRunStatus) IsApproved() bool {
+	return s == WorkflowRunStatusApproved
+}
+
+// NeedsInput reports whether the workflow run is waiting for the applicant or a manual task to be completed
+func (s WorkflowRunStatus) NeedsInput() bool {
+	return s == WorkflowRunStatusAwaitingInput
+}
+
 type CreateWorkflowRunPayload struct {
 	ApplicantID    string                 `json:"applicant_id,omitempty"`
 	WorkflowID     string                 `json:"workflow_id,omitempty"`
@@ -58,10 +95,87 @@ type CreateWorkflowRunPayload struct {
 }
 
 type CreateWorkflowRunLink struct {
-	CompletedRedirectURL string     `json:"completed_redirect_url,omitempty"`
-	ExpiredRedirectURL   string     `json:"expired_redirect_url,omitempty"`
-	ExpiresAt            *time.Time `json:"expires_at,omitempty"`
-	Language             string     `json:"language,omitempty"`
+	CompletedRedirectURL string       `json:"completed_redirect_url,omitempty"`
+	ExpiredRedirectURL   string       `json:"expired_redirect_url,omitempty"`
+	ExpiresAt            *time.Time   `json:"expires_at,omitempty"`
+	Language             LinkLanguage `json:"language,omitempty"`
+}
+
+// LinkLanguage is the language of the pages a workflow run link leads to, a locale such as "en_GB"
+//   - The languages declared here are not exhaustive, the API may support more languages
+type LinkLanguage string
+
+const (
+	LinkLanguageEnglishGB  LinkLanguage = "en_GB"
+	LinkLanguageEnglishUS  LinkLanguage = "en_US"
+	LinkLanguageGerman     LinkLanguage = "de_DE"
+	LinkLanguageSpanish    LinkLanguage = "es_ES"
+	LinkLanguageFrench     LinkLanguage = "fr_FR"
+	LinkLanguageItalian    LinkLanguage = "it_IT"
+	LinkLanguagePortuguese LinkLanguage = "pt_PT"
+	LinkLanguageDutch      LinkLanguage = "nl_NL"
+)
+
+// isLocale reports whether the language is a locale of the form xx_YY, the support of the locale is left to the API
+func (l LinkLanguage) isLocale() bool {
+	if len(l) != 5 || l[2] != '_' {
+		return false
+	}
+	isLower := func(b byte) bool { return b >= 'a' && b <= 'z' }
+	isUpper := func(b byte) bool { return b >= 'A' && b <= 'Z' }
+	return isLower(l[0]) && isLower(l[1]) && isUpper(l[3]) && isUpper(l[4])
+}
+
+// validate checks that the language of the link, when set, is a locale such as "en_GB"
+func (l CreateWorkflowRunLink) validate() error {
+	if l.Language != "" && !l.Language.isLocale() {
+		return &ValidationError{Field: "link.language", Message: fmt.Sprintf("link.language %q is not a locale of the form xx_YY, e.g. en_GB", l.Language)}
+	}
+	return nil
+}
+
+// SetCustomData sets the custom data of the payload from data, which must encode to a JSON object,
+// e.g. a struct with json tags, so that custom data doesn't have to be built as a map.
+// A [*ValidationError] is returned when data can't be encoded to a JSON object.
+func (p *CreateWorkflowRunPayload) SetCustomData(data any) error {
+	db, err := json.Marshal(data)
+	if err != nil {
+		return customDataError(err)
+	}
+
+	var customData map[string]any
+	if err := json.Unmarshal(db, &customData); err != nil {
+		return &ValidationError{Field: "custom_data", Message: "custom_data must be a JSON object"}
+	}
+
+	p.CustomData = customData
+	return nil
+}
+
+// DecodeCustomData decodes the custom data of the payload into dest, which must be a pointer
+func (p CreateWorkflowRunPayload) DecodeCustomData(dest any) error {
+	db, err := json.Marshal(p.CustomData)
+	if err != nil {
+		return customDataError(err)
+	}
+
+	if err := json.Unmarshal(db, dest); err != nil {
+		return fmt.Errorf("failed to decode custom data: %w", err)
+	}
+
+	return nil
+}
+
+// validateCustomData checks that the values of custom data can be encoded to JSON
+func validateCustomData(customData map[string]any) error {
+	if _, err := json.Marshal(customData); err != nil {
+		return customDataError(err)
+	}
+	return nil
+}
+
+func customDataError(err error) error {
+	return &ValidationError{Field: "custom_data", Message: fmt.Sprintf("custom_data must be JSON-serializable: %v", err)}
 }
 
 // WorkflowRunEvidenceSummary represents the evidence summary file response
@@ -69,6 +183,39 @@ type WorkflowRunEvidenceSummary struct {
 	URL string `json:"url,omitempty"`
 }
 
+// WorkflowRunTimelineFile represents a timeline file of a workflow run.
+//
+// ID and Href are set when the file is generated, URL is set when the file is retrieved.
+type WorkflowRunTimelineFile struct {
+	ID   string `json:"workflow_timeline_file_id,omitempty"`
+	Href string `json:"href,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// DecodeWorkflowRunOutput decodes the output of a workflow run into a T
+func DecodeWorkflowRunOutput[T any](workflowRun *WorkflowRun) (*T, error) {
+	var output T
+	if err := workflowRun.DecodeOutput(&output); err != nil {
+		return nil, err
+	}
+	return &output, nil
+}
+
+// DecodeOutput decodes the output of the workflow run, shaped by the output schema of its workflow
+// in Studio, into dest which must be a pointer, e.g. to a struct with json tags.
+func (w *WorkflowRun) DecodeOutput(dest any) error {
+	ob, err := json.Marshal(w.Output)
+	if err != nil {
+		return fmt.Errorf("failed to marshal workflow run output: %w", err)
+	}
+
+	if err := json.Unmarshal(ob, dest); err != nil {
+		return fmt.Errorf("failed to decode workflow run output: %w", err)
+	}
+
+	return nil
+}
+
 // ------------------------------------------------------------------
 //                              OPTIONS
 // ------------------------------------------------------------------
@@ -136,6 +283,15 @@ func WithWorkflowRunSort(sort sortDirection) ListWorkflowRunOption {
 
 // CreateWorkflowRun creates a new workflow run in the Onfido API
 func (c *Client) CreateWorkflowRun(ctx context.Context, payload CreateWorkflowRunPayload) (*WorkflowRun, error) {
+	if payload.Link != nil {
+		if err := payload.Link.validate(); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateCustomData(payload.CustomData); err != nil {
+		return nil, err
+	}
+
 	var workflowRun WorkflowRun
 
 	req := func() error {
@@ -144,7 +300,7 @@ func (c *Client) CreateWorkflowRun(ctx context.Context, payload CreateWorkflowRu
 			return err
 		}
 
-		resp, err := c.client.Post(ctx, "/workflow_runs", body)
+		resp, err := c.client.Post(ctx, "/workflow_runs", body, callHttpOptions(ctx)...)
 		if err != nil {
 			return err
 		}
@@ -168,7 +324,7 @@ func (c *Client) RetrieveWorkflowRun(ctx context.Context, workflowRunID string)
 	var workflowRun WorkflowRun
 
 	req := func() error {
-		resp, err := c.client.Get(ctx, "/workflow_runs/"+workflowRunID, c.getHttpRequestOptions(nil, nil)...)
+		resp, err := c.client.Get(ctx, "/workflow_runs/"+workflowRunID, c.getHttpRequestOptions(ctx, nil, nil)...)
 		if err != nil {
 			return err
 		}
@@ -191,7 +347,7 @@ func (c *Client) ListWorkflowRuns(ctx context.Context, opts ...IsListWorkflowRun
 	req := func() error {
 		params := c.getListWorkflowRunParams(opts...)
 
-		resp, err := c.client.Get(ctx, "/workflow_runs", c.getHttpRequestOptions(params, nil)...)
+		resp, err := c.client.Get(ctx, "/workflow_runs", c.getHttpRequestOptions(ctx, params, nil)...)
 		if err != nil {
 			return err
 		}
@@ -201,7 +357,7 @@ func (c *Client) ListWorkflowRuns(ctx context.Context, opts ...IsListWorkflowRun
 		}
 
 		pageDetails = c.extractPageDetails(resp.Headers)
-		return nil
+		return c.checkPageRange(params, len(workflowRuns), pageDetails)
 	}
 
 	if err := c.do(ctx, req); err != nil {
@@ -220,7 +376,7 @@ func (c *Client) RetrieveWorkflowRunEvidenceSummaryFile(ctx context.Context, wor
 	var evidenceSummary WorkflowRunEvidenceSummary
 
 	req := func() error {
-		resp, err := c.client.Get(ctx, "/workflow_runs/"+workflowRunID+"/signed_evidence_file", c.getHttpRequestOptions(nil, nil)...)
+		resp, err := c.client.Get(ctx, "/workflow_runs/"+workflowRunID+"/signed_evidence_file", c.getHttpRequestOptions(ctx, nil, nil)...)
 		if err != nil {
 			return err
 		}
@@ -246,6 +402,89 @@ func (c *Client) RetrieveWorkflowRunEvidenceSummaryFile(ctx context.Context, wor
 	return &evidenceSummary, nil
 }
 
+// GenerateWorkflowRunTimelineFile triggers the generation of the timeline file of a workflow run.
+// The file can be retrieved with [Client.RetrieveWorkflowRunTimelineFile] once generated.
+func (c *Client) GenerateWorkflowRunTimelineFile(ctx context.Context, workflowRunID string) (*WorkflowRunTimelineFile, error) {
+	if workflowRunID == "" {
+		return nil, ErrInvalidId
+	}
+
+	var timelineFile WorkflowRunTimelineFile
+
+	req := func() error {
+		resp, err := c.client.Post(ctx, "/workflow_runs/"+workflowRunID+"/timeline_file", nil, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &timelineFile)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &timelineFile, nil
+}
+
+// RetrieveWorkflowRunTimelineFile retrieves the URL of a generated timeline file of a workflow run
+func (c *Client) RetrieveWorkflowRunTimelineFile(ctx context.Context, workflowRunID, timelineFileID string) (*WorkflowRunTimelineFile, error) {
+	if workflowRunID == "" || timelineFileID == "" {
+		return nil, ErrInvalidId
+	}
+
+	var timelineFile WorkflowRunTimelineFile
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/workflow_runs/"+workflowRunID+"/timeline_file/"+timelineFileID, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		if err := c.getError(resp, true); err != nil {
+			return err
+		}
+
+		location := resp.Headers.Get("Location")
+		if location == "" {
+			return fmt.Errorf("failed to retrieve timeline file %s for %s", timelineFileID, workflowRunID)
+		}
+
+		timelineFile = WorkflowRunTimelineFile{ID: timelineFileID, URL: location}
+
+		return nil
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &timelineFile, nil
+}
+
+// ScanWorkflowRuns returns a Scanner over all the workflow runs matching the options, fetching the pages as needed
+func (c *Client) ScanWorkflowRuns(opts ...IsListWorkflowRunOption) *Scanner[WorkflowRun] {
+	return NewScanner(func(ctx context.Context, page int) ([]WorkflowRun, *PageDetails, error) {
+		return c.ListWorkflowRuns(ctx, append(opts[:len(opts):len(opts)], WithPage(page))...)
+	})
+}
+
+// ListAllWorkflowRuns retrieves every workflow run matching the filters of opts, reading all the
+// pages of the list. A page set with WithPage is ignored.
+func (c *Client) ListAllWorkflowRuns(ctx context.Context, opts ...IsListWorkflowRunOption) ([]WorkflowRun, error) {
+	var workflowRuns []WorkflowRun
+
+	scanner := c.ScanWorkflowRuns(opts...)
+	for scanner.Scan(ctx) {
+		workflowRuns = append(workflowRuns, scanner.Item())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return workflowRuns, nil
+}
+
 func (c Client) getListWorkflowRunParams(opts ...IsListWorkflowRunOption) (params map[string]string) {
 	pg := paginationOption{}
 	options := &listWorkflowRunOptions{
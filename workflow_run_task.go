@@ -0,0 +1,229 @@
+package onfido
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// ------------------------------------------------------------------
+//                          WORKFLOW RUN TASK
+// ------------------------------------------------------------------
+
+// WorkflowRunTask represents a task of a workflow run in the Onfido API
+type WorkflowRunTask struct {
+	ID             string         `json:"id,omitempty"`
+	WorkflowRunID  string         `json:"workflow_run_id,omitempty"`
+	TaskDefID      string         `json:"task_def_id,omitempty"`
+	TaskDefVersion string         `json:"task_def_version,omitempty"`
+	Input          map[string]any `json:"input,omitempty"`
+	Output         map[string]any `json:"output,omitempty"`
+	CreatedAt      *time.Time     `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time     `json:"updated_at,omitempty"`
+	Raw            RawJSON        `json:"-"`
+}
+
+func (w *WorkflowRunTask) UnmarshalJSON(data []byte) error {
+	type workflowRunTask WorkflowRunTask
+	if err := json.Unmarshal(data, (*workflowRunTask)(w)); err != nil {
+		return err
+	}
+
+	w.Raw = newRawJSON(data)
+	return nil
+}
+
+// ProfileDataTaskOutput is the output of the profile data task of Studio workflows
+type ProfileDataTaskOutput struct {
+	FirstName   string     `json:"first_name,omitempty"`
+	LastName    string     `json:"last_name,omitempty"`
+	Dob         string     `json:"dob,omitempty"`
+	Email       string     `json:"email,omitempty"`
+	PhoneNumber string     `json:"phone_number,omitempty"`
+	Nationality string     `json:"nationality,omitempty"`
+	IdNumbers   []IdNumber `json:"id_numbers,omitempty"`
+	Address     *Address   `json:"address,omitempty"`
+}
+
+// DocumentCaptureTaskOutput is the output of the document capture task of Studio workflows
+type DocumentCaptureTaskOutput struct {
+	DocumentIDs []TaskDocumentRef `json:"document_ids,omitempty"`
+}
+
+// TaskDocumentRef references a document captured during a task
+type TaskDocumentRef struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+const (
+	TaskDefProfileData         = "profile_data"
+	TaskDefUploadDocumentPhoto = "upload_document_photo"
+)
+
+// ------------------------------------------------------------------
+//                          OUTPUT REGISTRY
+// ------------------------------------------------------------------
+
+var taskOutputTypes = struct {
+	sync.RWMutex
+	types map[string]reflect.Type
+}{
+	types: map[string]reflect.Type{
+		TaskDefProfileData:         reflect.TypeOf(ProfileDataTaskOutput{}),
+		TaskDefUploadDocumentPhoto: reflect.TypeOf(DocumentCaptureTaskOutput{}),
+	},
+}
+
+// RegisterTaskOutput registers T as the output type of the tasks of a task definition,
+// so that [WorkflowRunTask.DecodeOutput] decodes their output into a *T.
+// Registering a task definition again replaces its output type.
+func RegisterTaskOutput[T any](taskDefID string) {
+	taskOutputTypes.Lock()
+	defer taskOutputTypes.Unlock()
+
+	taskOutputTypes.types[taskDefID] = reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// DecodeTaskOutput decodes the output of a task into a T.
+//
+// When an output type is registered for the task definition, see [RegisterTaskOutput], T must be that
+// type, so that DecodeTaskOutput and [WorkflowRunTask.DecodeOutput] always agree.
+func DecodeTaskOutput[T any](task *WorkflowRunTask) (*T, error) {
+	want := reflect.TypeOf((*T)(nil)).Elem()
+	if outputType, ok := registeredTaskOutput(task.TaskDefID); ok && outputType != want {
+		return nil, fmt.Errorf("output of task definition %q is registered as %s, not %s", task.TaskDefID, outputType, want)
+	}
+
+	var output T
+	if err := task.decodeOutput(&output); err != nil {
+		return nil, err
+	}
+	return &output, nil
+}
+
+// DecodeOutput decodes the output of the task into the type registered for its task definition
+// and returns a pointer to it, e.g. a *ProfileDataTaskOutput for the profile data task.
+func (t *WorkflowRunTask) DecodeOutput() (any, error) {
+	outputType, ok := registeredTaskOutput(t.TaskDefID)
+	if !ok {
+		return nil, fmt.Errorf("no output type registered for task definition %q", t.TaskDefID)
+	}
+
+	output := reflect.New(outputType).Interface()
+	if err := t.decodeOutput(output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// registeredTaskOutput returns the output type registered for a task definition, if any
+func registeredTaskOutput(taskDefID string) (reflect.Type, bool) {
+	taskOutputTypes.RLock()
+	defer taskOutputTypes.RUnlock()
+
+	outputType, ok := taskOutputTypes.types[taskDefID]
+	return outputType, ok
+}
+
+func (t *WorkflowRunTask) decodeOutput(dest any) error {
+	ob, err := json.Marshal(t.Output)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task output: %w", err)
+	}
+
+	if err := json.Unmarshal(ob, dest); err != nil {
+		return fmt.Errorf("failed to decode task output: %w", err)
+	}
+
+	return nil
+}
+
+// ------------------------------------------------------------------
+//                              METHODS
+// ------------------------------------------------------------------
+
+// ListWorkflowRunTasks retrieves the tasks of a workflow run from the Onfido API.
+//
+// Listed tasks only carry their id, task definition and timestamps, use [Client.RetrieveWorkflowRunTask]
+// to fetch their input and output.
+func (c *Client) ListWorkflowRunTasks(ctx context.Context, workflowRunID string) ([]WorkflowRunTask, error) {
+	if workflowRunID == "" {
+		return nil, ErrInvalidId
+	}
+
+	var tasks []WorkflowRunTask
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/workflow_runs/"+workflowRunID+"/tasks", c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &tasks)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// RetrieveWorkflowRunTask retrieves a task of a workflow run, with its input and output, from the Onfido API
+func (c *Client) RetrieveWorkflowRunTask(ctx context.Context, workflowRunID, taskID string) (*WorkflowRunTask, error) {
+	if workflowRunID == "" || taskID == "" {
+		return nil, ErrInvalidId
+	}
+
+	var task WorkflowRunTask
+
+	req := func() error {
+		resp, err := c.client.Get(ctx, "/workflow_runs/"+workflowRunID+"/tasks/"+taskID, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, &task)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+// CompleteWorkflowRunTask completes a custom task of a workflow run in the Onfido API.
+//
+// The data is the output of the task, e.g. a [ProfileDataTaskOutput] for profile data tasks.
+func (c *Client) CompleteWorkflowRunTask(ctx context.Context, workflowRunID, taskID string, data any) error {
+	if workflowRunID == "" || taskID == "" {
+		return ErrInvalidId
+	}
+
+	req := func() error {
+		body, err := c.buildJSON(struct {
+			Data any `json:"data"`
+		}{data})
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.Post(ctx, "/workflow_runs/"+workflowRunID+"/tasks/"+taskID+"/complete", body, c.getHttpRequestOptions(ctx, nil, nil)...)
+		if err != nil {
+			return err
+		}
+
+		return c.getResponseOrError(resp, nil)
+	}
+
+	if err := c.do(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
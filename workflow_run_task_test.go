@@ -0,0 +1,235 @@
+package onfido_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/besafe-labs/onfido-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowRunTask(t *testing.T) {
+	run := setupTestRun(t)
+	defer run.teardown()
+
+	applicant, err := run.client.CreateApplicant(run.ctx, onfido.CreateApplicantPayload{
+		FirstName: "John",
+		LastName:  "WorkflowRunTaskTest",
+	})
+	if err != nil {
+		t.Fatalf("error creating applicant: %v", err)
+	}
+
+	workflowRun, err := run.client.CreateWorkflowRun(run.ctx, onfido.CreateWorkflowRunPayload{
+		ApplicantID: applicant.ID,
+		WorkflowID:  os.Getenv("ONFIDO_WORKFLOW_ID"),
+	})
+	if err != nil {
+		t.Fatalf("error creating workflow run: %v", err)
+	}
+
+	t.Run("ListWorkflowRunTasks", testListWorkflowRunTasks(run, workflowRun.ID))
+
+	tasks, err := run.client.ListWorkflowRunTasks(run.ctx, workflowRun.ID)
+	if err != nil || len(tasks) == 0 {
+		t.Fatalf("error listing workflow run tasks: %v", err)
+	}
+	t.Run("RetrieveWorkflowRunTask", testRetrieveWorkflowRunTask(run, workflowRun.ID, tasks[0].ID))
+	t.Run("CompleteWorkflowRunTask", testCompleteWorkflowRunTask(run, workflowRun.ID, tasks))
+}
+
+func testListWorkflowRunTasks(run *testRun, workflowRunId string) func(*testing.T) {
+	tests := []testCase[string]{
+		{
+			name:  "ListWithoutErrors",
+			input: workflowRunId,
+		},
+		{
+			name:    "ReturnErrorOnEmptyID",
+			input:   "",
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				tasks, err := run.client.ListWorkflowRunTasks(run.ctx, tt.input)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.NotEmpty(t, tasks, "expected workflow run to have tasks")
+				for _, task := range tasks {
+					assert.NotEmpty(t, task.ID, "expected task ID to be set")
+					assert.NotEmpty(t, task.TaskDefID, "expected task definition ID to be set")
+				}
+			})
+		}
+	}
+}
+
+func testRetrieveWorkflowRunTask(run *testRun, workflowRunId, taskId string) func(*testing.T) {
+	type input struct {
+		workflowRunId string
+		taskId        string
+	}
+
+	tests := []testCase[input]{
+		{
+			name:  "RetrieveWithoutErrors",
+			input: input{workflowRunId, taskId},
+		},
+		{
+			name:    "ReturnErrorOnInvalidTaskID",
+			input:   input{workflowRunId, "invalid-id"},
+			wantErr: true,
+			errMsg:  "resource_not_found",
+		},
+		{
+			name:    "ReturnErrorOnEmptyWorkflowRunID",
+			input:   input{"", taskId},
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+		{
+			name:    "ReturnErrorOnEmptyTaskID",
+			input:   input{workflowRunId, ""},
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				task, err := run.client.RetrieveWorkflowRunTask(run.ctx, tt.input.workflowRunId, tt.input.taskId)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+				assert.Equal(t, tt.input.taskId, task.ID, "expected task ID to match")
+				assert.Equal(t, tt.input.workflowRunId, task.WorkflowRunID, "expected workflow run ID to match")
+			})
+		}
+	}
+}
+
+func testCompleteWorkflowRunTask(run *testRun, workflowRunId string, tasks []onfido.WorkflowRunTask) func(*testing.T) {
+	type input struct {
+		workflowRunId string
+		taskId        string
+		data          any
+	}
+
+	profileData := onfido.ProfileDataTaskOutput{FirstName: "John", LastName: "WorkflowRunTaskTest"}
+	tests := []testCase[input]{
+		{
+			name:    "ReturnErrorOnInvalidTaskID",
+			input:   input{workflowRunId, "invalid-id", profileData},
+			wantErr: true,
+			errMsg:  "resource_not_found",
+		},
+		{
+			name:    "ReturnErrorOnEmptyWorkflowRunID",
+			input:   input{"", "invalid-id", profileData},
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+		{
+			name:    "ReturnErrorOnEmptyTaskID",
+			input:   input{workflowRunId, "", profileData},
+			wantErr: true,
+			errMsg:  "validation_error",
+		},
+	}
+
+	// the profile data task is only present if the test workflow starts with one
+	for _, task := range tasks {
+		if task.TaskDefID == onfido.TaskDefProfileData {
+			tests = append([]testCase[input]{{
+				name:  "CompleteProfileDataWithoutErrors",
+				input: input{workflowRunId, task.ID, profileData},
+			}}, tests...)
+		}
+	}
+
+	return func(t *testing.T) {
+		sleep(t, 5)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := run.client.CompleteWorkflowRunTask(run.ctx, tt.input.workflowRunId, tt.input.taskId, tt.input.data)
+				if tt.wantErr {
+					assert.Errorf(t, err, expectedError, tt.name, err)
+					assert.Containsf(t, err.Error(), tt.errMsg, errorContains, tt.errMsg, err.Error())
+					return
+				}
+
+				assert.NoErrorf(t, err, expectedNoError, tt.name, err)
+			})
+		}
+	}
+}
+
+func TestWorkflowRunTaskOutput(t *testing.T) {
+	task := &onfido.WorkflowRunTask{
+		ID:        "profile_data_1a2b3c",
+		TaskDefID: onfido.TaskDefProfileData,
+		Output: map[string]any{
+			"first_name": "John",
+			"last_name":  "Doe",
+			"address":    map[string]any{"country": "GBR", "postcode": "SW4 6EH"},
+		},
+	}
+
+	t.Run("DecodeRegisteredOutput", func(t *testing.T) {
+		output, err := task.DecodeOutput()
+		assert.NoErrorf(t, err, expectedNoError, "DecodeOutput", err)
+
+		profile, ok := output.(*onfido.ProfileDataTaskOutput)
+		if assert.Truef(t, ok, "expected profile data output. got %T", output) {
+			assert.Equal(t, "John", profile.FirstName)
+			assert.Equal(t, "SW4 6EH", profile.Address.Postcode)
+		}
+	})
+
+	t.Run("DecodeCustomOutput", func(t *testing.T) {
+		type customOutput struct {
+			Score float64 `json:"score"`
+		}
+		onfido.RegisterTaskOutput[customOutput]("custom_score")
+
+		output, err := (&onfido.WorkflowRunTask{TaskDefID: "custom_score", Output: map[string]any{"score": 0.9}}).DecodeOutput()
+		assert.NoErrorf(t, err, expectedNoError, "DecodeOutput", err)
+		assert.Equal(t, &customOutput{Score: 0.9}, output)
+	})
+
+	t.Run("DecodeTaskOutputGeneric", func(t *testing.T) {
+		profile, err := onfido.DecodeTaskOutput[onfido.ProfileDataTaskOutput](task)
+		assert.NoErrorf(t, err, expectedNoError, "DecodeTaskOutput", err)
+		assert.Equal(t, "Doe", profile.LastName)
+
+		unregistered, err := onfido.DecodeTaskOutput[map[string]string](&onfido.WorkflowRunTask{TaskDefID: "unregistered", Output: map[string]any{"key": "value"}})
+		assert.NoErrorf(t, err, expectedNoError, "DecodeTaskOutput", err)
+		assert.Equal(t, map[string]string{"key": "value"}, *unregistered)
+	})
+
+	t.Run("ReturnErrorOnTypeOtherThanRegistered", func(t *testing.T) {
+		_, err := onfido.DecodeTaskOutput[onfido.DocumentCaptureTaskOutput](task)
+		assert.Errorf(t, err, expectedError, "DecodeTaskOutput", err)
+	})
+
+	t.Run("ReturnErrorOnUnregisteredTaskDefinition", func(t *testing.T) {
+		_, err := (&onfido.WorkflowRunTask{TaskDefID: "unknown"}).DecodeOutput()
+		assert.Errorf(t, err, expectedError, "DecodeOutput", err)
+	})
+}
@@ -1,7 +1,15 @@
 package onfido_test
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -31,6 +39,8 @@ func TestWorkflowRun(t *testing.T) {
 	}
 	t.Run("RetrieveWorkflowRun", testRetrieveWorkflowRun(run, testWorkflowRun.ID))
 	t.Run("RetrieveWorkflowRunEvidenceSummaryFile", testRetrieveWorkflowRunEvidenceSummaryFile(run, testWorkflowRun.ID))
+	t.Run("WorkflowRunTimelineFile", testWorkflowRunTimelineFile(run, testWorkflowRun.ID))
+	t.Run("DownloadWorkflowRunEvidence", testDownloadWorkflowRunEvidence(run, testWorkflowRun.ID))
 	linkExpiry = time.Now().Add(30 * time.Minute).Truncate(time.Second).UTC()
 	t.Run("ListWorkflowRuns", testListWorkflowRuns(run, linkExpiry))
 }
@@ -48,7 +58,7 @@ func testCreateWorkflowRun(run *testRun, applicant *onfido.Applicant, expiry tim
 				CustomerUserID: "customer-user-id",
 				Link: &onfido.CreateWorkflowRunLink{
 					ExpiresAt: &expiry,
-					Language:  "en_GB",
+					Language:  onfido.LinkLanguageEnglishGB,
 				},
 				CustomData: map[string]any{
 					"document_id": []any{},
@@ -153,6 +163,65 @@ func testRetrieveWorkflowRun(run *testRun, workflowId string) func(*testing.T) {
 	}
 }
 
+func testWorkflowRunTimelineFile(run *testRun, workflowRunId string) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Run("GenerateWithoutErrors", func(t *testing.T) {
+			timelineFile, err := run.client.GenerateWorkflowRunTimelineFile(run.ctx, workflowRunId)
+			if err != nil {
+				t.Fatalf("error generating timeline file: %v", err)
+			}
+			assert.NotEmpty(t, timelineFile.ID, "expected timeline file ID to be set")
+
+			sleep(t, 10)
+			retrieved, err := run.client.RetrieveWorkflowRunTimelineFile(run.ctx, workflowRunId, timelineFile.ID)
+			assert.NoErrorf(t, err, expectedNoError, "RetrieveWorkflowRunTimelineFile", err)
+			if assert.NotNil(t, retrieved, "expected timeline file to be retrieved") {
+				assert.NotEmpty(t, retrieved.URL, "expected timeline file url to not be empty")
+			}
+		})
+
+		t.Run("ReturnErrorOnEmptyID", func(t *testing.T) {
+			_, err := run.client.GenerateWorkflowRunTimelineFile(run.ctx, "")
+			assert.Errorf(t, err, expectedError, "GenerateWorkflowRunTimelineFile", err)
+			assert.Containsf(t, err.Error(), "validation_error", errorContains, "validation_error", err.Error())
+
+			_, err = run.client.RetrieveWorkflowRunTimelineFile(run.ctx, workflowRunId, "")
+			assert.Errorf(t, err, expectedError, "RetrieveWorkflowRunTimelineFile", err)
+			assert.Containsf(t, err.Error(), "validation_error", errorContains, "validation_error", err.Error())
+		})
+	}
+}
+
+func testDownloadWorkflowRunEvidence(run *testRun, workflowRunId string) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Run("DownloadWithoutErrors", func(t *testing.T) {
+			var buf bytes.Buffer
+			err := run.client.DownloadWorkflowRunEvidence(run.ctx, workflowRunId, &buf)
+			if err != nil {
+				t.Fatalf("error downloading workflow run evidence: %v", err)
+			}
+
+			archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			if err != nil {
+				t.Fatalf("error reading evidence archive: %v", err)
+			}
+
+			names := make([]string, len(archive.File))
+			for i, file := range archive.File {
+				names[i] = file.Name
+			}
+			assert.Contains(t, names, "signed_evidence_file.pdf", "expected signed evidence file to be archived")
+		})
+
+		t.Run("ReturnErrorOnEmptyID", func(t *testing.T) {
+			var buf bytes.Buffer
+			err := run.client.DownloadWorkflowRunEvidence(run.ctx, "", &buf)
+			assert.Errorf(t, err, expectedError, "DownloadWorkflowRunEvidence", err)
+			assert.Containsf(t, err.Error(), "validation_error", errorContains, "validation_error", err.Error())
+		})
+	}
+}
+
 func testRetrieveWorkflowRunEvidenceSummaryFile(run *testRun, workflowRunId string) func(*testing.T) {
 	tests := []testCase[string]{
 		{
@@ -372,3 +441,223 @@ func testListWorkflowRuns(run *testRun, linkExpiry time.Time) func(*testing.T) {
 		}
 	}
 }
+
+func TestListAllWorkflowRuns(t *testing.T) {
+	var queries []url.Values
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		queries = append(queries, query)
+
+		header := http.Header{"Content-Type": {"application/json"}, "X-Total-Count": {"3"}}
+		body := `[{"id":"run-3"}]`
+		if query.Get("page") == "1" {
+			header.Set("Link", `<https://api.eu.onfido.com/v3.6/workflow_runs?page=2&status=approved>; rel="next", <https://api.eu.onfido.com/v3.6/workflow_runs?page=2&status=approved>; rel="last"`)
+			body = `[{"id":"run-1"},{"id":"run-2"}]`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	workflowRuns, err := client.ListAllWorkflowRuns(context.Background(), onfido.WithWorkflowRunStatus(onfido.WorkflowRunStatusApproved))
+	assert.NoErrorf(t, err, expectedNoError, "ListAllWorkflowRuns", err)
+
+	var ids []string
+	for _, workflowRun := range workflowRuns {
+		ids = append(ids, workflowRun.ID)
+	}
+	assert.Equal(t, []string{"run-1", "run-2", "run-3"}, ids, "expected workflow runs of every page")
+
+	if assert.Len(t, queries, 2, "expected a request per page") {
+		for i, query := range queries {
+			assert.Equal(t, strconv.Itoa(i+1), query.Get("page"))
+			assert.Equal(t, "approved", query.Get("status"), "expected filters to apply to every page")
+		}
+	}
+}
+
+func TestWorkflowRunDecodeOutput(t *testing.T) {
+	type output struct {
+		Decision   string  `json:"decision"`
+		Score      float64 `json:"score"`
+		DocumentID string  `json:"document_id"`
+	}
+
+	workflowRun := &onfido.WorkflowRun{Output: map[string]any{
+		"decision":    "approved",
+		"score":       0.98,
+		"document_id": "document-1",
+		"unmapped":    true,
+	}}
+	want := output{Decision: "approved", Score: 0.98, DocumentID: "document-1"}
+
+	t.Run("DecodeIntoStruct", func(t *testing.T) {
+		var got output
+		err := workflowRun.DecodeOutput(&got)
+		assert.NoErrorf(t, err, expectedNoError, "DecodeOutput", err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("DecodeGeneric", func(t *testing.T) {
+		got, err := onfido.DecodeWorkflowRunOutput[output](workflowRun)
+		if assert.NoErrorf(t, err, expectedNoError, "DecodeWorkflowRunOutput", err) {
+			assert.Equal(t, want, *got)
+		}
+	})
+
+	t.Run("ReturnErrorOnMismatchedType", func(t *testing.T) {
+		var got struct {
+			Score string `json:"score"`
+		}
+		err := workflowRun.DecodeOutput(&got)
+		assert.Errorf(t, err, expectedError, "DecodeOutput", err)
+	})
+
+	t.Run("DecodeEmptyOutput", func(t *testing.T) {
+		var got output
+		err := (&onfido.WorkflowRun{}).DecodeOutput(&got)
+		assert.NoErrorf(t, err, expectedNoError, "DecodeOutput", err)
+		assert.Zero(t, got)
+	})
+}
+
+func TestWorkflowRunCustomData(t *testing.T) {
+	type customData struct {
+		AccountID string   `json:"account_id"`
+		Tier      int      `json:"tier"`
+		Products  []string `json:"products,omitempty"`
+	}
+
+	t.Run("SetAndDecodeStruct", func(t *testing.T) {
+		want := customData{AccountID: "account-1", Tier: 2, Products: []string{"cards"}}
+
+		var payload onfido.CreateWorkflowRunPayload
+		err := payload.SetCustomData(want)
+		if !assert.NoErrorf(t, err, expectedNoError, "SetCustomData", err) {
+			return
+		}
+		assert.Equal(t, "account-1", payload.CustomData["account_id"])
+
+		var got customData
+		err = payload.DecodeCustomData(&got)
+		assert.NoErrorf(t, err, expectedNoError, "DecodeCustomData", err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("RejectNonObjects", func(t *testing.T) {
+		var payload onfido.CreateWorkflowRunPayload
+		err := payload.SetCustomData([]string{"account-1"})
+		assert.ErrorIsf(t, err, onfido.ErrValidation, expectedError, "SetCustomData", err)
+		assert.Nil(t, payload.CustomData, "expected custom data to be left unset")
+	})
+
+	t.Run("RejectUnserializableValues", func(t *testing.T) {
+		var payload onfido.CreateWorkflowRunPayload
+		err := payload.SetCustomData(map[string]any{"callback": func() {}})
+		assert.ErrorIsf(t, err, onfido.ErrValidation, expectedError, "SetCustomData", err)
+	})
+
+	t.Run("ValidateBeforeCreating", func(t *testing.T) {
+		transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			t.Errorf("expected no request to be sent, got %s %s", req.Method, req.URL.Path)
+			return nil, http.ErrNotSupported
+		})
+
+		client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+		if err != nil {
+			t.Fatalf("error setting up client: %v", err)
+		}
+		defer teardown()
+
+		_, err = client.CreateWorkflowRun(context.Background(), onfido.CreateWorkflowRunPayload{
+			ApplicantID: "applicant-1",
+			WorkflowID:  "workflow-1",
+			CustomData:  map[string]any{"limit": math.Inf(1)},
+		})
+
+		var validationErr *onfido.ValidationError
+		if assert.ErrorAsf(t, err, &validationErr, expectedError, "CreateWorkflowRun", err) {
+			assert.Equal(t, "custom_data", validationErr.Field)
+		}
+	})
+}
+
+func TestWorkflowRunLinkLanguage(t *testing.T) {
+	var requests int
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		body := `{"id":"run-1","link":{"url":"https://eu.onfido.app/l/run-1","language":"de_DE"}}`
+		return &http.Response{StatusCode: http.StatusCreated, Header: http.Header{"Content-Type": {"application/json"}}, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})
+
+	client, teardown, err := setupClient("token", onfido.WithTransport(transport))
+	if err != nil {
+		t.Fatalf("error setting up client: %v", err)
+	}
+	defer teardown()
+
+	payload := func(language onfido.LinkLanguage) onfido.CreateWorkflowRunPayload {
+		return onfido.CreateWorkflowRunPayload{
+			ApplicantID: "applicant-1",
+			WorkflowID:  "workflow-1",
+			Link:        &onfido.CreateWorkflowRunLink{Language: language},
+		}
+	}
+
+	t.Run("AcceptSupportedLanguage", func(t *testing.T) {
+		workflowRun, err := client.CreateWorkflowRun(context.Background(), payload(onfido.LinkLanguageGerman))
+		if assert.NoErrorf(t, err, expectedNoError, "CreateWorkflowRun", err) {
+			assert.Equal(t, onfido.LinkLanguageGerman, workflowRun.Link.Language)
+		}
+	})
+
+	t.Run("AcceptUndeclaredLanguage", func(t *testing.T) {
+		requests = 0
+		_, err := client.CreateWorkflowRun(context.Background(), payload("pl_PL"))
+		assert.NoErrorf(t, err, expectedNoError, "CreateWorkflowRun", err)
+		assert.Equal(t, 1, requests, "expected the request to be sent")
+	})
+
+	t.Run("RejectMalformedLanguage", func(t *testing.T) {
+		for _, language := range []onfido.LinkLanguage{"en-GB", "en", "EN_gb", "eng_GB"} {
+			requests = 0
+			_, err := client.CreateWorkflowRun(context.Background(), payload(language))
+
+			var validationErr *onfido.ValidationError
+			if assert.ErrorAsf(t, err, &validationErr, expectedError, "CreateWorkflowRun with "+string(language), err) {
+				assert.Equal(t, "link.language", validationErr.Field)
+			}
+			assert.Zero(t, requests, "expected no request to be sent")
+		}
+	})
+}
+
+func TestWorkflowRunStatus(t *testing.T) {
+	tests := []struct {
+		status       onfido.WorkflowRunStatus
+		wantTerminal bool
+		wantApproved bool
+		wantInput    bool
+	}{
+		{status: onfido.WorkflowRunStatusProcessing},
+		{status: onfido.WorkflowRunStatusAwaitingInput, wantInput: true},
+		{status: onfido.WorkflowRunStatusApproved, wantTerminal: true, wantApproved: true},
+		{status: onfido.WorkflowRunStatusDeclined, wantTerminal: true},
+		{status: onfido.WorkflowRunStatusReview},
+		{status: onfido.WorkflowRunStatusAbandoned, wantTerminal: true},
+		{status: onfido.WorkflowRunStatusError, wantTerminal: true},
+		{status: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			assert.Equal(t, tt.wantTerminal, tt.status.IsTerminal(), "IsTerminal")
+			assert.Equal(t, tt.wantApproved, tt.status.IsApproved(), "IsApproved")
+			assert.Equal(t, tt.wantInput, tt.status.NeedsInput(), "NeedsInput")
+		})
+	}
+}